@@ -7,6 +7,15 @@ import (
 // ------------------------------------------------------------------------------------------------
 const RGBABytesPerPixel = 4
 
+// ------------------------------------------------------------------------------------------------
+// PixelSource is anything pixels can be read from, such as a PixelBuffer or a GogiCanvas.
+// Renderers and encoders accept it so they work with either.
+type PixelSource interface {
+	Width() int
+	Height() int
+	GetPixel(x, y int) colour.Colour
+}
+
 // ------------------------------------------------------------------------------------------------
 type PixelBuffer struct {
 	width, height int
@@ -29,6 +38,48 @@ func NewPixelBuffer(width, height int, buffer []uint8) *PixelBuffer {
 	}
 }
 
+// ------------------------------------------------------------------------------------------------
+// WrapPixelBuffer creates a PixelBuffer that uses the given pixels directly instead of taking
+// a copy, so changes through either are visible to both.
+func WrapPixelBuffer(width, height int, buffer []uint8) *PixelBuffer {
+	if len(buffer) != width*height*RGBABytesPerPixel {
+		panic("buffer size mismatch")
+	}
+
+	return &PixelBuffer{
+		width: width, height: height, pixels: buffer, bytesPerPixel: RGBABytesPerPixel,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *PixelBuffer) Width() int {
+	return p.width
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *PixelBuffer) Height() int {
+	return p.height
+}
+
+// ------------------------------------------------------------------------------------------------
+// Clear sets every pixel in the buffer to the empty (transparent) colour.
+func (p *PixelBuffer) Clear() {
+	for i := range p.pixels {
+		p.pixels[i] = 0
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Fill sets every pixel in the buffer to c, without blending.
+func (p *PixelBuffer) Fill(c colour.Colour) {
+	for offset := 0; offset < len(p.pixels); offset += p.bytesPerPixel {
+		p.pixels[offset] = c.R
+		p.pixels[offset+1] = c.G
+		p.pixels[offset+2] = c.B
+		p.pixels[offset+3] = c.A
+	}
+}
+
 // ------------------------------------------------------------------------------------------------
 func (p *PixelBuffer) GetPixel(x, y int) colour.Colour {
 	if x < 0 || x >= p.width || y < 0 || y >= p.height {
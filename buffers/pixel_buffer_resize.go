@@ -0,0 +1,106 @@
+package buffers
+
+// ------------------------------------------------------------------------------------------------
+// ResizeMode decides what happens to the existing content when a buffer is resized.
+type ResizeMode int
+
+const (
+	// ResizeClear empties the buffer.
+	ResizeClear ResizeMode = iota
+	// ResizePreserve keeps the content anchored at the top left, cropping or padding with
+	// transparent pixels as needed.
+	ResizePreserve
+	// ResizeScale stretches the content to the new size using nearest neighbour sampling.
+	ResizeScale
+)
+
+// ------------------------------------------------------------------------------------------------
+// Resize changes the buffer dimensions, reusing the existing memory when it is large enough.
+// Negative dimensions are treated as zero.
+func (p *PixelBuffer) Resize(width, height int, mode ResizeMode) {
+	width, height = max(0, width), max(0, height)
+
+	p.pixels = ResizePixels(p.pixels, p.width, p.height, width, height, mode)
+	p.width = width
+	p.height = height
+}
+
+// ------------------------------------------------------------------------------------------------
+// ResizePixels resizes an RGBA pixel slice from oldWidth x oldHeight to width x height and
+// returns the result. The capacity of pixels is reused when it is large enough, so growing
+// and shrinking back and forth doesn't allocate every time. Negative dimensions are treated as
+// zero.
+func ResizePixels(pixels []uint8, oldWidth, oldHeight, width, height int, mode ResizeMode) []uint8 {
+	oldWidth, oldHeight = max(0, oldWidth), max(0, oldHeight)
+	width, height = max(0, width), max(0, height)
+
+	size := width * height * RGBABytesPerPixel
+
+	var resized []uint8
+	if cap(pixels) >= size {
+		resized = pixels[:size]
+	} else {
+		resized = make([]uint8, size)
+	}
+
+	switch mode {
+	case ResizePreserve:
+		preservePixels(resized, pixels, oldWidth, oldHeight, width, height)
+	case ResizeScale:
+		// scaling reads from all over the old content, so it needs its own copy
+		old := make([]uint8, oldWidth*oldHeight*RGBABytesPerPixel)
+		copy(old, pixels)
+		scalePixels(resized, old, oldWidth, oldHeight, width, height)
+	default:
+		clear(resized)
+	}
+
+	return resized
+}
+
+// ------------------------------------------------------------------------------------------------
+// preservePixels copies the overlapping area row by row. dst and src may share memory, so
+// rows are copied in the order that never overwrites a source row before it is used.
+func preservePixels(dst, src []uint8, oldWidth, oldHeight, width, height int) {
+	rowBytes := min(oldWidth, width) * RGBABytesPerPixel
+	rows := min(oldHeight, height)
+	newStride := width * RGBABytesPerPixel
+	oldStride := oldWidth * RGBABytesPerPixel
+
+	copyRow := func(y int) {
+		copy(dst[y*newStride:y*newStride+rowBytes], src[y*oldStride:y*oldStride+rowBytes])
+		clear(dst[y*newStride+rowBytes : (y+1)*newStride])
+	}
+
+	if width <= oldWidth {
+		for y := 0; y < rows; y++ {
+			copyRow(y)
+		}
+	} else {
+		for y := rows - 1; y >= 0; y-- {
+			copyRow(y)
+		}
+	}
+
+	clear(dst[rows*newStride:])
+}
+
+// ------------------------------------------------------------------------------------------------
+// scalePixels stretches src over dst using nearest neighbour sampling.
+func scalePixels(dst, src []uint8, oldWidth, oldHeight, width, height int) {
+	if oldWidth <= 0 || oldHeight <= 0 {
+		clear(dst)
+		return
+	}
+
+	for y := range height {
+		srcY := y * oldHeight / height
+		for x := range width {
+			srcX := x * oldWidth / width
+
+			from := (srcY*oldWidth + srcX) * RGBABytesPerPixel
+			to := (y*width + x) * RGBABytesPerPixel
+			copy(dst[to:to+RGBABytesPerPixel], src[from:from+RGBABytesPerPixel])
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package buffers
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func newTestBuffer(width, height int) *PixelBuffer {
+	pb := NewPixelBuffer(width, height, make([]uint8, width*height*RGBABytesPerPixel))
+
+	// give every pixel a unique colour based on its position
+	for y := range height {
+		for x := range width {
+			pb.ColourPutPixel(x, y, colour.NewColour(uint8(x), uint8(y), 0, 255))
+		}
+	}
+
+	return pb
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestResizePreserve(t *testing.T) {
+	tests := []struct {
+		width, height int
+		name          string
+	}{
+		{width: 3, height: 2, name: "Shrink"},
+		{width: 6, height: 7, name: "Grow"},
+		{width: 2, height: 6, name: "Narrower and taller"},
+		{width: 8, height: 3, name: "Wider and shorter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pb := newTestBuffer(4, 4)
+			pb.Resize(tt.width, tt.height, ResizePreserve)
+
+			if pb.Width() != tt.width || pb.Height() != tt.height || len(pb.pixels) != tt.width*tt.height*4 {
+				t.Fatalf("Unexpected size %dx%d with %d bytes", pb.Width(), pb.Height(), len(pb.pixels))
+			}
+
+			for y := range tt.height {
+				for x := range tt.width {
+					expected := colour.Colour{}
+					if x < 4 && y < 4 {
+						expected = colour.NewColour(uint8(x), uint8(y), 0, 255)
+					}
+
+					if pixel := pb.GetPixel(x, y); pixel != expected {
+						t.Errorf("Expected pixel at (%d, %d) to be %v, got %v", x, y, expected, pixel)
+					}
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestResizeReusesCapacity(t *testing.T) {
+	pb := newTestBuffer(10, 10)
+	first := &pb.pixels[0]
+
+	pb.Resize(5, 5, ResizeClear)
+	pb.Resize(10, 10, ResizeClear)
+
+	if &pb.pixels[0] != first {
+		t.Error("Expected the original memory to be reused")
+	}
+
+	if pixel := pb.GetPixel(9, 9); !pixel.IsEmpty() {
+		t.Errorf("Expected a cleared buffer, got %v", pixel)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestResizeScale(t *testing.T) {
+	pb := newTestBuffer(2, 2)
+	pb.Resize(4, 4, ResizeScale)
+
+	for y := range 4 {
+		for x := range 4 {
+			expected := colour.NewColour(uint8(x/2), uint8(y/2), 0, 255)
+			if pixel := pb.GetPixel(x, y); pixel != expected {
+				t.Errorf("Expected pixel at (%d, %d) to be %v, got %v", x, y, expected, pixel)
+			}
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestResizeNegativeSizes(t *testing.T) {
+	tests := []struct {
+		width, height int
+		name          string
+	}{
+		{width: -1, height: 4, name: "Negative width"},
+		{width: 4, height: -3, name: "Negative height"},
+		{width: -2, height: -2, name: "Both negative"},
+	}
+
+	for _, mode := range []ResizeMode{ResizeClear, ResizePreserve, ResizeScale} {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				pb := newTestBuffer(4, 4)
+				pb.Resize(tt.width, tt.height, mode)
+
+				expectedWidth, expectedHeight := max(0, tt.width), max(0, tt.height)
+				if pb.Width() != expectedWidth || pb.Height() != expectedHeight || len(pb.pixels) != 0 {
+					t.Errorf("Expected %dx%d with no pixels, but got %dx%d with %d bytes",
+						expectedWidth, expectedHeight, pb.Width(), pb.Height(), len(pb.pixels))
+				}
+
+				// growing again afterwards must work from the empty buffer
+				pb.Resize(2, 2, mode)
+				if len(pb.pixels) != 2*2*RGBABytesPerPixel {
+					t.Errorf("Expected %d bytes after growing, but got %d", 2*2*RGBABytesPerPixel, len(pb.pixels))
+				}
+			})
+		}
+	}
+}
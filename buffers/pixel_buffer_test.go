@@ -61,3 +61,37 @@ func TestBlendColors(t *testing.T) {
 		t.Errorf("Expected (255, 127, 127, 255), got %v", blended)
 	}
 }
+
+// ------------------------------------------------------------------------------------------------
+func TestWrapPixelBufferSharesMemory(t *testing.T) {
+	buffer := make([]uint8, 2*2*RGBABytesPerPixel)
+	pb := WrapPixelBuffer(2, 2, buffer)
+
+	pb.ColourPutPixel(1, 1, colour.NewColour(1, 2, 3, 255))
+	if buffer[12] != 1 || buffer[13] != 2 || buffer[14] != 3 {
+		t.Errorf("Expected the wrapped buffer to be updated, got %v", buffer)
+	}
+
+	var source PixelSource = pb
+	if source.Width() != 2 || source.Height() != 2 {
+		t.Error("Expected the buffer to work as a pixel source")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFill(t *testing.T) {
+	pb := NewPixelBuffer(3, 2, make([]uint8, 3*2*RGBABytesPerPixel))
+	pb.ColourPutPixel(1, 1, colour.NewColourWhite())
+
+	// a translucent fill replaces pixels rather than blending with them
+	fill := colour.NewColour(10, 20, 30, 128)
+	pb.Fill(fill)
+
+	for y := range 2 {
+		for x := range 3 {
+			if got := pb.GetPixel(x, y); got != fill {
+				t.Errorf("Expected %v at %d,%d, but got %v", fill, x, y, got)
+			}
+		}
+	}
+}
@@ -12,19 +12,48 @@ type GogiCanvas struct {
 	bufferSize    int
 	pixelBuffer   []uint8
 
+	pages      [][]uint8
+	activePage int
+	visualPage int
+
 	activeColour colour.Colour
 	savedColour  colour.Colour
+
+	path Path
+
+	layers      []*Layer
+	layersDirty bool
+
+	// layerBase caches the composite of the bottom layerBaseCount layers, which stay clean
+	layerBase      []uint8
+	layerBaseCount int
+
+	dirtyRects  []Rect
+	dirtyExport []int32
 }
 
 // ------------------------------------------------------------------------------------------------
 func NewCanvas(width, height int) *GogiCanvas {
+	return NewCanvasWithPages(width, height, 1)
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewCanvasWithPages creates a canvas with several pixel buffers, or pages. Use 2 pages for
+// double buffering and 3 for triple buffering. Drawing always happens on the active page,
+// while GetBufferPointer returns the visual page. See Present and Flip.
+func NewCanvasWithPages(width, height, pageCount int) *GogiCanvas {
 	temp := GogiCanvas{
 		width:  width,
 		height: height,
 	}
 
 	temp.bufferSize = width * height * colour.BYTES_PER_PIXEL
-	temp.pixelBuffer = make([]uint8, temp.bufferSize)
+	temp.pages = make([][]uint8, max(1, pageCount))
+	for i := range temp.pages {
+		temp.pages[i] = make([]uint8, temp.bufferSize)
+	}
+	temp.pixelBuffer = temp.pages[0]
+	temp.MarkAllDirty()
 
 	return &temp
 }
@@ -41,6 +70,7 @@ func (m *GogiCanvas) ClearBuffer() {
 	for i := range m.pixelBuffer {
 		m.pixelBuffer[i] = 0
 	}
+	m.MarkAllDirty()
 }
 
 // ------------------------------------------------------------------------------------------------
@@ -54,10 +84,12 @@ func (m *GogiCanvas) Height() int {
 }
 
 // ------------------------------------------------------------------------------------------------
+// GetBufferPointer returns the address of the visual page, which is the last completed
+// frame when using more than one page.
 func (m *GogiCanvas) GetBufferPointer() uintptr {
 	// unsafe.Pointer(&pixelBuffer[0]) gets the address of the first element so that
 	// we can pass it back to JavaScript
-	return uintptr(unsafe.Pointer(&m.pixelBuffer[0]))
+	return uintptr(unsafe.Pointer(&m.pages[m.visualPage][0]))
 }
 
 // ------------------------------------------------------------------------------------------------
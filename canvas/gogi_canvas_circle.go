@@ -8,15 +8,17 @@ func (m *GogiCanvas) DrawCircle(cx, cy int, radius int, col colour.Colour) {
 	y := radius
 	p := 3 - 2*radius
 
+	m.markDirty(cx-radius, cy-radius, 2*radius+1, 2*radius+1)
+
 	drawOctants := func(x, y int) {
-		m.ColourPutPixel(cx+x, cy+y, col)
-		m.ColourPutPixel(cx-x, cy+y, col)
-		m.ColourPutPixel(cx+x, cy-y, col)
-		m.ColourPutPixel(cx-x, cy-y, col)
-		m.ColourPutPixel(cx+y, cy+x, col)
-		m.ColourPutPixel(cx-y, cy+x, col)
-		m.ColourPutPixel(cx+y, cy-x, col)
-		m.ColourPutPixel(cx-y, cy-x, col)
+		m.colourPutPixel(cx+x, cy+y, col)
+		m.colourPutPixel(cx-x, cy+y, col)
+		m.colourPutPixel(cx+x, cy-y, col)
+		m.colourPutPixel(cx-x, cy-y, col)
+		m.colourPutPixel(cx+y, cy+x, col)
+		m.colourPutPixel(cx-y, cy+x, col)
+		m.colourPutPixel(cx+y, cy-x, col)
+		m.colourPutPixel(cx-y, cy-x, col)
 	}
 
 	for x <= y {
@@ -38,6 +40,8 @@ func (m *GogiCanvas) DrawFilledCircle(cx, cy int, radius int, col colour.Colour)
 	y := radius
 	p := 3 - 2*radius
 
+	m.markDirty(cx-radius, cy-radius, 2*radius+1, 2*radius+1)
+
 	// Helper function to draw a horizontal line
 	// Assumes startX <= endX
 	drawHorizontalLine := func(x1, x2, y int) {
@@ -45,7 +49,7 @@ func (m *GogiCanvas) DrawFilledCircle(cx, cy int, radius int, col colour.Colour)
 			x1, x2 = x2, x1
 		}
 		for i := x1; i <= x2; i++ {
-			m.ColourPutPixel(i, y, col)
+			m.colourPutPixel(i, y, col)
 		}
 	}
 
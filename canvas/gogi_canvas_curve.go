@@ -0,0 +1,45 @@
+package canvas
+
+import "github.com/ewaldhorn/gogi/curve"
+
+// ------------------------------------------------------------------------------------------------
+// CURVE_TOLERANCE is how far, in pixels, the lines a curve is drawn with may stray from it.
+const CURVE_TOLERANCE = 0.25
+
+// ------------------------------------------------------------------------------------------------
+// DrawCurve draws any curve using the active colour, with round ends unless WithCap says
+// otherwise.
+func (m *GogiCanvas) DrawCurve(c curve.Curve, options ...StrokeOption) {
+	m.strokePolyline(curve.Flatten(c, CURVE_TOLERANCE), newPolylineStyle(options))
+}
+
+// ------------------------------------------------------------------------------------------------
+// DrawQuadraticBezier draws a curve from p0 to p2, bending towards p1.
+func (m *GogiCanvas) DrawQuadraticBezier(p0, p1, p2 Point, options ...StrokeOption) {
+	m.DrawCurve(curve.Quadratic{P0: p0.Vec2(), P1: p1.Vec2(), P2: p2.Vec2()}, options...)
+}
+
+// ------------------------------------------------------------------------------------------------
+// DrawCubicBezier draws a curve from p0 to p3, leaving towards p1 and arriving from p2.
+func (m *GogiCanvas) DrawCubicBezier(p0, p1, p2, p3 Point, options ...StrokeOption) {
+	m.DrawCurve(curve.Cubic{P0: p0.Vec2(), P1: p1.Vec2(), P2: p2.Vec2(), P3: p3.Vec2()}, options...)
+}
+
+// ------------------------------------------------------------------------------------------------
+// DrawCatmullRom draws a smooth curve through all the points.
+func (m *GogiCanvas) DrawCatmullRom(points []Point, options ...StrokeOption) {
+	if len(points) == 0 {
+		return
+	}
+	m.DrawCurve(curve.NewCatmullRom(toVec2s(points)), options...)
+}
+
+// ------------------------------------------------------------------------------------------------
+// DrawBSpline draws a smooth curve from the first to the last point, pulled towards the
+// points in between.
+func (m *GogiCanvas) DrawBSpline(points []Point, options ...StrokeOption) {
+	if len(points) == 0 {
+		return
+	}
+	m.DrawCurve(curve.NewBSpline(toVec2s(points)), options...)
+}
@@ -0,0 +1,198 @@
+package canvas
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/curve"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawCurves(t *testing.T) {
+	white := colour.NewColourWhite()
+
+	tests := []struct {
+		name     string
+		draw     func(c *GogiCanvas)
+		expected []Point
+	}{
+		{"quadratic", func(c *GogiCanvas) {
+			c.DrawQuadraticBezier(Point{X: 2, Y: 2}, Point{X: 10, Y: 18}, Point{X: 18, Y: 2})
+		}, []Point{{X: 2, Y: 2}, {X: 10, Y: 10}, {X: 18, Y: 2}}},
+		{"cubic", func(c *GogiCanvas) {
+			c.DrawCubicBezier(Point{X: 2, Y: 10}, Point{X: 2, Y: 2}, Point{X: 18, Y: 2}, Point{X: 18, Y: 10})
+		}, []Point{{X: 2, Y: 10}, {X: 10, Y: 4}, {X: 18, Y: 10}}},
+		{"catmull-rom", func(c *GogiCanvas) {
+			c.DrawCatmullRom([]Point{{X: 2, Y: 10}, {X: 8, Y: 4}, {X: 14, Y: 16}, {X: 18, Y: 10}})
+		}, []Point{{X: 2, Y: 10}, {X: 8, Y: 4}, {X: 14, Y: 16}, {X: 18, Y: 10}}},
+		{"b-spline", func(c *GogiCanvas) {
+			c.DrawBSpline([]Point{{X: 2, Y: 2}, {X: 10, Y: 2}, {X: 18, Y: 2}})
+		}, []Point{{X: 2, Y: 2}, {X: 10, Y: 2}, {X: 18, Y: 2}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCanvas(20, 20)
+			c.SetColour(white)
+			tt.draw(c)
+
+			for _, p := range tt.expected {
+				if got := c.GetPixel(p.X, p.Y); got != white {
+					t.Errorf("Expected pixel at (%d, %d) to be white, but got %v", p.X, p.Y, got)
+				}
+			}
+
+			if got := c.GetPixel(10, 19); got != (colour.Colour{}) {
+				t.Errorf("Expected pixels away from the curve to be untouched, but got %v", got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawSplinesWithoutPoints(t *testing.T) {
+	c := NewCanvas(10, 10)
+	c.SetColour(colour.NewColourWhite())
+	c.DrawCatmullRom(nil, WithThickness(5))
+	c.DrawBSpline([]Point{}, WithThickness(5))
+
+	for y := range 10 {
+		for x := range 10 {
+			if got := c.GetPixel(x, y); got != (colour.Colour{}) {
+				t.Fatalf("Expected an untouched canvas, but got %v at (%d, %d)", got, x, y)
+			}
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawCurveIsConnected(t *testing.T) {
+	c := NewCanvas(40, 40)
+	c.SetColour(colour.NewColourWhite())
+	c.DrawCubicBezier(Point{X: 2, Y: 38}, Point{X: 2, Y: 0}, Point{X: 38, Y: 40}, Point{X: 38, Y: 2})
+
+	// a one pixel line leaves no gaps, so every column between the ends has a pixel
+	for x := 2; x <= 38; x++ {
+		found := false
+		for y := range 40 {
+			if c.GetPixel(x, y).A != 0 {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Expected a pixel in column %d", x)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawCurveThickness(t *testing.T) {
+	c := NewCanvas(20, 20)
+	c.SetColour(colour.NewColourWhite())
+	c.DrawCurve(curve.Quadratic{P0: vecmath.Vec2{X: 2, Y: 10}, P1: vecmath.Vec2{X: 10, Y: 10}, P2: vecmath.Vec2{X: 18, Y: 10}}, WithThickness(5))
+
+	tests := []struct {
+		name   string
+		y      int
+		filled bool
+	}{
+		{"centre", 10, true},
+		{"inside the top edge", 8, true},
+		{"inside the bottom edge", 12, true},
+		{"above", 7, false},
+		{"below", 13, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.GetPixel(10, tt.y).A != 0; got != tt.filled {
+				t.Errorf("Expected pixel at (10, %d) filled to be %v, but got %v", tt.y, tt.filled, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawCurveAntiAlias(t *testing.T) {
+	c := NewCanvas(20, 20)
+	c.SetColour(colour.NewColourWhite())
+
+	// a line through the middle of a row of pixels covers them fully, and half a pixel away
+	// covers neighbours partly
+	c.DrawPolyline([]Point{{X: 2, Y: 10}, {X: 18, Y: 10}}, WithThickness(2), WithAntiAlias())
+
+	if got := c.GetPixel(10, 10); got.A != 255 {
+		t.Errorf("Expected the centre to be solid, but got %v", got)
+	}
+	if got := c.GetPixel(10, 11); got.A != 128 {
+		t.Errorf("Expected the edge to be half covered, but got %v", got)
+	}
+	if got := c.GetPixel(10, 12); got.A != 0 {
+		t.Errorf("Expected nothing beyond the edge, but got %v", got)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawPolylineBlendsOnce(t *testing.T) {
+	c := NewCanvas(20, 20)
+	c.SetColour(colour.NewColour(255, 255, 255, 128))
+
+	// the shared corner would be blended twice if each segment was drawn on its own
+	c.DrawPolyline([]Point{{X: 2, Y: 2}, {X: 10, Y: 2}, {X: 10, Y: 10}})
+
+	corner, edge := c.GetPixel(10, 2), c.GetPixel(6, 2)
+	if corner != edge {
+		t.Errorf("Expected the corner to match the rest of the line, but got %v and %v", corner, edge)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawPolylineDashes(t *testing.T) {
+	c := NewCanvas(40, 10)
+	c.SetColour(colour.NewColourWhite())
+	c.DrawPolyline([]Point{{X: 0, Y: 5}, {X: 39, Y: 5}}, WithDash(0, 4, 4))
+
+	// dashes run from 0 to 4, 8 to 12 and so on, with round ends half a pixel long
+	for x := range 40 {
+		drawn := x%8 <= 4
+		if got := c.GetPixel(x, 5).A != 0; got != drawn {
+			t.Errorf("Expected pixel %d drawn to be %v, but got %v", x, drawn, got)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawPolylineJoinsAndCaps(t *testing.T) {
+	corner := []Point{{X: 5, Y: 20}, {X: 20, Y: 20}, {X: 20, Y: 35}}
+
+	// the ends are round by default, and the corner rounded off; with flat ends nothing is
+	// drawn before the start, and a miter fills the corner out to (23, 17) where a round
+	// join only covers part of (22, 18)
+	tests := []struct {
+		name        string
+		options     []StrokeOption
+		beforeStart bool
+		outerCorner bool
+	}{
+		{"round by default", nil, true, false},
+		{"flat ends", []StrokeOption{WithCap(CapButt)}, false, false},
+		{"mitered corner", []StrokeOption{WithJoin(JoinMiter)}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCanvas(40, 40)
+			c.SetColour(colour.NewColourWhite())
+			c.DrawPolyline(corner, append([]StrokeOption{WithThickness(6), WithAntiAlias()}, tt.options...)...)
+
+			if got := c.GetPixel(3, 20).A != 0; got != tt.beforeStart {
+				t.Errorf("Expected a pixel before the start to be %v, but got %v", tt.beforeStart, got)
+			}
+			if got := c.GetPixel(22, 18).A == 255; got != tt.outerCorner {
+				t.Errorf("Expected the outer corner solid to be %v, but got %v", tt.outerCorner, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package canvas
+
+import "unsafe"
+
+// ------------------------------------------------------------------------------------------------
+// MAX_DIRTY_RECTS limits how many separate dirty areas are tracked. Once exceeded, all the
+// dirty areas are collapsed into a single bounding rectangle.
+const MAX_DIRTY_RECTS = 16
+
+// ------------------------------------------------------------------------------------------------
+// markDirty records that the given area of the canvas changed. The area is clipped to the
+// canvas and merged with any dirty rectangle it overlaps or touches.
+func (m *GogiCanvas) markDirty(x, y, width, height int) {
+	area := Rect{X: x, Y: y, Width: width, Height: height}.Intersect(m.Bounds())
+	if area.IsEmpty() {
+		return
+	}
+
+	// merging can make the new area touch rectangles it did not touch before, so keep
+	// going until nothing else merges
+	for merged := true; merged; {
+		merged = false
+
+		for i, r := range m.dirtyRects {
+			if r.Touches(area) {
+				area = area.Union(r)
+				m.dirtyRects = append(m.dirtyRects[:i], m.dirtyRects[i+1:]...)
+				merged = true
+				break
+			}
+		}
+	}
+
+	m.dirtyRects = append(m.dirtyRects, area)
+
+	if len(m.dirtyRects) > MAX_DIRTY_RECTS {
+		bounds := Rect{}
+		for _, r := range m.dirtyRects {
+			bounds = bounds.Union(r)
+		}
+		m.dirtyRects = append(m.dirtyRects[:0], bounds)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Bounds returns the rectangle covering the whole canvas.
+func (m *GogiCanvas) Bounds() Rect {
+	return Rect{Width: m.width, Height: m.height}
+}
+
+// ------------------------------------------------------------------------------------------------
+// MarkAllDirty flags the whole canvas as changed, useful after writing to the buffer
+// without going through the drawing functions.
+func (m *GogiCanvas) MarkAllDirty() {
+	m.dirtyRects = append(m.dirtyRects[:0], m.Bounds())
+}
+
+// ------------------------------------------------------------------------------------------------
+// IsDirty reports whether anything was drawn since the dirty rectangles were last cleared.
+func (m *GogiCanvas) IsDirty() bool {
+	return len(m.dirtyRects) > 0
+}
+
+// ------------------------------------------------------------------------------------------------
+// DirtyRects returns the areas changed since the dirty rectangles were last cleared.
+func (m *GogiCanvas) DirtyRects() []Rect {
+	rects := make([]Rect, len(m.dirtyRects))
+	copy(rects, m.dirtyRects)
+	return rects
+}
+
+// ------------------------------------------------------------------------------------------------
+// ClearDirtyRects forgets all the tracked changes, typically called once the host has
+// uploaded the dirty areas.
+func (m *GogiCanvas) ClearDirtyRects() {
+	m.dirtyRects = m.dirtyRects[:0]
+}
+
+// ------------------------------------------------------------------------------------------------
+// GetDirtyRectCount returns the number of dirty rectangles currently tracked.
+func (m *GogiCanvas) GetDirtyRectCount() uint32 {
+	return uint32(len(m.dirtyRects))
+}
+
+// ------------------------------------------------------------------------------------------------
+// GetDirtyRectsPointer packs the dirty rectangles as int32 x, y, width, height quadruplets
+// and returns the address of the first value so JavaScript can read them straight out of
+// WebAssembly memory. Returns 0 when nothing is dirty.
+func (m *GogiCanvas) GetDirtyRectsPointer() uintptr {
+	m.dirtyExport = m.dirtyExport[:0]
+	for _, r := range m.dirtyRects {
+		m.dirtyExport = append(m.dirtyExport, int32(r.X), int32(r.Y), int32(r.Width), int32(r.Height))
+	}
+
+	if len(m.dirtyExport) == 0 {
+		return 0
+	}
+
+	return uintptr(unsafe.Pointer(&m.dirtyExport[0]))
+}
@@ -0,0 +1,103 @@
+package canvas
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestNewCanvasIsFullyDirty(t *testing.T) {
+	canvas := NewCanvas(10, 8)
+
+	rects := canvas.DirtyRects()
+	if len(rects) != 1 || rects[0] != canvas.Bounds() {
+		t.Errorf("Expected a new canvas to be fully dirty, but got %v", rects)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDirtyRectsFromDrawing(t *testing.T) {
+	canvas := NewCanvas(100, 100)
+	canvas.ClearDirtyRects()
+	red := colour.NewColour(255, 0, 0, 255)
+
+	canvas.DrawRectangle(10, 10, 5, 5, red)
+	canvas.DrawFilledCircle(80, 80, 3, red)
+
+	rects := canvas.DirtyRects()
+	if len(rects) != 2 {
+		t.Fatalf("Expected 2 separate dirty rects, but got %v", rects)
+	}
+
+	if rects[0] != (Rect{X: 10, Y: 10, Width: 5, Height: 5}) {
+		t.Errorf("Unexpected rectangle dirty area %v", rects[0])
+	}
+
+	if rects[1] != (Rect{X: 77, Y: 77, Width: 7, Height: 7}) {
+		t.Errorf("Unexpected circle dirty area %v", rects[1])
+	}
+
+	// a touching pixel should be merged with the rectangle
+	canvas.ColourPutPixel(15, 12, red)
+	rects = canvas.DirtyRects()
+	if len(rects) != 2 || rects[1] != (Rect{X: 10, Y: 10, Width: 6, Height: 5}) {
+		t.Errorf("Expected the pixel to merge with the rectangle, but got %v", rects)
+	}
+
+	canvas.ClearDirtyRects()
+	if canvas.IsDirty() {
+		t.Error("Expected no dirty rects after clearing")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDirtyRectsClippedAndCollapsed(t *testing.T) {
+	canvas := NewCanvas(100, 100)
+	canvas.ClearDirtyRects()
+	white := colour.NewColourWhite()
+
+	canvas.DrawRectangle(-10, -10, 15, 15, white)
+	if rects := canvas.DirtyRects(); rects[0] != (Rect{Width: 5, Height: 5}) {
+		t.Errorf("Expected the dirty rect to be clipped, but got %v", rects[0])
+	}
+
+	canvas.ColourPutPixel(200, 200, white)
+	if canvas.GetDirtyRectCount() != 1 {
+		t.Errorf("Expected off canvas pixels to be ignored, but got %v", canvas.DirtyRects())
+	}
+
+	for i := range MAX_DIRTY_RECTS {
+		canvas.ColourPutPixel(10+i*3, 50, white)
+	}
+
+	rects := canvas.DirtyRects()
+	if len(rects) != 1 || rects[0] != (Rect{X: 0, Y: 0, Width: 56, Height: 51}) {
+		t.Errorf("Expected dirty rects to collapse into one, but got %v", rects)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestGetDirtyRectsPointer(t *testing.T) {
+	canvas := NewCanvas(20, 10)
+
+	pointer := canvas.GetDirtyRectsPointer()
+	if pointer == 0 {
+		t.Fatal("Expected a pointer to the dirty rects")
+	}
+
+	if pointer != uintptr(unsafe.Pointer(&canvas.dirtyExport[0])) {
+		t.Error("Expected the pointer to address the packed dirty rects")
+	}
+
+	values := canvas.dirtyExport
+	if len(values) != 4 || values[0] != 0 || values[1] != 0 || values[2] != 20 || values[3] != 10 {
+		t.Errorf("Expected packed full canvas rect, but got %v", values)
+	}
+
+	canvas.ClearDirtyRects()
+	if canvas.GetDirtyRectsPointer() != 0 {
+		t.Error("Expected a zero pointer when nothing is dirty")
+	}
+}
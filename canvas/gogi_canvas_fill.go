@@ -0,0 +1,204 @@
+package canvas
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// PATH_SUBSAMPLES is how many rows are sampled per pixel when filling, for anti-aliasing.
+// Coverage along each row is exact.
+const PATH_SUBSAMPLES = 16
+
+// ------------------------------------------------------------------------------------------------
+// FillRule decides which parts of a path that crosses itself are inside.
+type FillRule int
+
+const (
+	// FillNonZero fills everywhere the path winds around, unless it winds back out as often
+	// as it winds in.
+	FillNonZero FillRule = iota
+	// FillEvenOdd fills places surrounded an odd number of times, so overlaps become holes.
+	FillEvenOdd
+)
+
+// ------------------------------------------------------------------------------------------------
+// fillEdge is a non-horizontal polygon edge, stored top to bottom.
+type fillEdge struct {
+	top, bottom float64
+	x, slope    float64
+	winding     int
+}
+
+// ------------------------------------------------------------------------------------------------
+// crossing is where an edge crosses a sample row.
+type crossing struct {
+	x       float64
+	winding int
+}
+
+// ------------------------------------------------------------------------------------------------
+// FillPath fills the path with the active colour, with anti-aliased edges. Open subpaths are
+// closed with a straight line.
+func (m *GogiCanvas) FillPath(p *Path, rule FillRule) {
+	var polygons [][]vecmath.Vec2
+	for _, sp := range p.polylines() {
+		polygons = append(polygons, sp.points)
+	}
+	m.fillPolygons(polygons, rule, true)
+}
+
+// ------------------------------------------------------------------------------------------------
+// fillPolygons blends the active colour into the area the polygons enclose. Pixel centres sit
+// at whole coordinates, so pixel x covers x-0.5 to x+0.5. Without anti-aliasing, pixels at
+// least half covered are filled and the rest left alone.
+func (m *GogiCanvas) fillPolygons(polygons [][]vecmath.Vec2, rule FillRule, antiAlias bool) {
+	var edges []fillEdge
+	low := vecmath.Vec2{X: math.Inf(1), Y: math.Inf(1)}
+	high := vecmath.Vec2{X: math.Inf(-1), Y: math.Inf(-1)}
+
+	for _, polygon := range polygons {
+		for i, a := range polygon {
+			b := polygon[(i+1)%len(polygon)]
+			low = vecmath.Vec2{X: math.Min(low.X, a.X), Y: math.Min(low.Y, a.Y)}
+			high = vecmath.Vec2{X: math.Max(high.X, a.X), Y: math.Max(high.Y, a.Y)}
+
+			if a.Y == b.Y {
+				continue
+			}
+
+			winding := 1
+			if a.Y > b.Y {
+				a, b = b, a
+				winding = -1
+			}
+			slope := (b.X - a.X) / (b.Y - a.Y)
+			edges = append(edges, fillEdge{top: a.Y, bottom: b.Y, x: a.X, slope: slope, winding: winding})
+		}
+	}
+
+	if len(edges) == 0 {
+		return
+	}
+
+	area := Rect{X: int(math.Floor(low.X + 0.5)), Y: int(math.Floor(low.Y + 0.5))}
+	area.Width = int(math.Ceil(high.X+0.5)) - area.X
+	area.Height = int(math.Ceil(high.Y+0.5)) - area.Y
+	area = area.Intersect(m.Bounds())
+	if area.IsEmpty() {
+		return
+	}
+
+	slices.SortFunc(edges, func(a, b fillEdge) int {
+		return cmp.Compare(a.top, b.top)
+	})
+
+	coverage := make([]float64, area.Width*area.Height)
+	weight := 1.0 / PATH_SUBSAMPLES
+
+	var active []fillEdge
+	var crossings []crossing
+	next := 0
+
+	for y := range area.Height {
+		row := coverage[y*area.Width : (y+1)*area.Width]
+
+		for sample := range PATH_SUBSAMPLES {
+			sampleY := float64(area.Y+y) - 0.5 + (float64(sample)+0.5)*weight
+
+			for next < len(edges) && edges[next].top <= sampleY {
+				active = append(active, edges[next])
+				next++
+			}
+
+			// drop edges that ended, and find where the rest cross this row
+			crossings = crossings[:0]
+			remaining := active[:0]
+			for _, e := range active {
+				if e.bottom <= sampleY {
+					continue
+				}
+				remaining = append(remaining, e)
+				crossings = append(crossings, crossing{x: e.x + (sampleY-e.top)*e.slope, winding: e.winding})
+			}
+			active = remaining
+
+			slices.SortFunc(crossings, func(a, b crossing) int {
+				return cmp.Compare(a.x, b.x)
+			})
+
+			winding := 0
+			for i, c := range crossings[:max(0, len(crossings)-1)] {
+				winding += c.winding
+
+				inside := winding != 0
+				if rule == FillEvenOdd {
+					inside = winding%2 != 0
+				}
+				if inside {
+					addSpan(row, float64(area.X), c.x, crossings[i+1].x, weight)
+				}
+			}
+		}
+	}
+
+	if !antiAlias {
+		for i, amount := range coverage {
+			if amount >= 0.5 {
+				coverage[i] = 1
+			} else {
+				coverage[i] = 0
+			}
+		}
+	}
+
+	m.markDirty(area.X, area.Y, area.Width, area.Height)
+	m.fillCoverage(area, coverage)
+}
+
+// ------------------------------------------------------------------------------------------------
+// fillCoverage blends the active colour into area, scaling its alpha by how much of each
+// pixel is covered.
+func (m *GogiCanvas) fillCoverage(area Rect, coverage []float64) {
+	for y := range area.Height {
+		for x := range area.Width {
+			amount := coverage[y*area.Width+x]
+			if amount <= 0 {
+				continue
+			}
+
+			c := m.activeColour
+			c.A = uint8(float64(c.A)*math.Min(1, amount) + 0.5)
+			m.colourPutPixel(area.X+x, area.Y+y, c)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// addSpan adds weight times how much of each pixel in row lies between from and to. The row
+// starts with the pixel centred on left.
+func addSpan(row []float64, left, from, to, weight float64) {
+	// move to row coordinates, where pixel i covers i to i+1
+	from = math.Max(0, from-left+0.5)
+	to = math.Min(float64(len(row)), to-left+0.5)
+	if from >= to {
+		return
+	}
+
+	first, last := int(from), int(to)
+	if first == last {
+		row[first] += (to - from) * weight
+		return
+	}
+
+	row[first] += (float64(first+1) - from) * weight
+	for i := first + 1; i < last; i++ {
+		row[i] += weight
+	}
+	if last < len(row) {
+		row[last] += (to - float64(last)) * weight
+	}
+}
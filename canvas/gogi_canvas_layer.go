@@ -0,0 +1,350 @@
+package canvas
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// BlendMode determines how a layer's pixels are combined with the layers below it.
+type BlendMode int
+
+const (
+	BlendNormal BlendMode = iota
+	BlendAdd
+	BlendMultiply
+	BlendScreen
+)
+
+// ------------------------------------------------------------------------------------------------
+// Layer is a single PixelBuffer in the canvas layer stack. Changing any of the layer
+// properties marks the layer as dirty. When drawing straight into the buffer returned
+// by Buffer(), call MarkDirty() so the next FlattenLayers picks up the change.
+type Layer struct {
+	buffer           *buffers.PixelBuffer
+	visible          bool
+	opacity          uint8
+	offsetX, offsetY int
+	blendMode        BlendMode
+	dirty            bool
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewLayer creates a visible, fully opaque layer using the normal blend mode.
+func NewLayer(width, height int) *Layer {
+	return &Layer{
+		buffer:    buffers.NewPixelBuffer(width, height, make([]uint8, width*height*buffers.RGBABytesPerPixel)),
+		visible:   true,
+		opacity:   colour.MAX_COLOUR_VALUE,
+		blendMode: BlendNormal,
+		dirty:     true,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) Buffer() *buffers.PixelBuffer {
+	return l.buffer
+}
+
+// ------------------------------------------------------------------------------------------------
+// ColourPutPixel draws into the layer buffer and marks the layer as dirty.
+func (l *Layer) ColourPutPixel(x, y int, p colour.Colour) {
+	l.buffer.ColourPutPixel(x, y, p)
+	l.dirty = true
+}
+
+// ------------------------------------------------------------------------------------------------
+// Clear empties the layer buffer and marks the layer as dirty.
+func (l *Layer) Clear() {
+	l.buffer.Clear()
+	l.dirty = true
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) MarkDirty() {
+	l.dirty = true
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) IsDirty() bool {
+	return l.dirty
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) IsVisible() bool {
+	return l.visible
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) SetVisible(visible bool) {
+	if l.visible != visible {
+		l.visible = visible
+		l.dirty = true
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) Opacity() uint8 {
+	return l.opacity
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetOpacity sets the layer opacity, 0 being invisible and 255 being fully opaque.
+func (l *Layer) SetOpacity(opacity uint8) {
+	if l.opacity != opacity {
+		l.opacity = opacity
+		l.dirty = true
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) Offset() (x, y int) {
+	return l.offsetX, l.offsetY
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetOffset positions the top left corner of the layer on the canvas.
+func (l *Layer) SetOffset(x, y int) {
+	if l.offsetX != x || l.offsetY != y {
+		l.offsetX, l.offsetY = x, y
+		l.dirty = true
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) BlendMode() BlendMode {
+	return l.blendMode
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Layer) SetBlendMode(mode BlendMode) {
+	if l.blendMode != mode {
+		l.blendMode = mode
+		l.dirty = true
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// AddLayer creates a new layer on top of the stack and returns it.
+func (m *GogiCanvas) AddLayer(width, height int) *Layer {
+	layer := NewLayer(width, height)
+	m.layers = append(m.layers, layer)
+	m.layersDirty = true
+	m.invalidateLayerBase(len(m.layers) - 1)
+	return layer
+}
+
+// ------------------------------------------------------------------------------------------------
+// InsertLayer places an existing layer at the given stack position, 0 being the bottom.
+// Out of range positions are clamped to the bottom or top of the stack.
+func (m *GogiCanvas) InsertLayer(index int, layer *Layer) {
+	index = max(0, min(index, len(m.layers)))
+
+	m.layers = append(m.layers, nil)
+	copy(m.layers[index+1:], m.layers[index:])
+	m.layers[index] = layer
+	m.layersDirty = true
+	m.invalidateLayerBase(index)
+}
+
+// ------------------------------------------------------------------------------------------------
+// RemoveLayer takes a layer off the stack, returning false if it was not found.
+func (m *GogiCanvas) RemoveLayer(layer *Layer) bool {
+	index := m.layerIndex(layer)
+	if index < 0 {
+		return false
+	}
+
+	m.layers = append(m.layers[:index], m.layers[index+1:]...)
+	m.layersDirty = true
+	m.invalidateLayerBase(index)
+	return true
+}
+
+// ------------------------------------------------------------------------------------------------
+// MoveLayer moves a layer to a new stack position, returning false if it was not found.
+func (m *GogiCanvas) MoveLayer(layer *Layer, index int) bool {
+	if !m.RemoveLayer(layer) {
+		return false
+	}
+
+	m.InsertLayer(index, layer)
+	return true
+}
+
+// ------------------------------------------------------------------------------------------------
+// Layers returns the layer stack, bottom layer first.
+func (m *GogiCanvas) Layers() []*Layer {
+	layers := make([]*Layer, len(m.layers))
+	copy(layers, m.layers)
+	return layers
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) LayerCount() int {
+	return len(m.layers)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) layerIndex(layer *Layer) int {
+	for i, l := range m.layers {
+		if l == layer {
+			return i
+		}
+	}
+	return -1
+}
+
+// ------------------------------------------------------------------------------------------------
+// FlattenLayers composites all visible layers, bottom to top, into the canvas buffer.
+// When neither the stack nor any layer changed since the last call, the canvas buffer
+// is left alone and false is returned. Layers below the lowest changed one are composited
+// into a cache the first time they are clean, after which only the changed layer and those
+// above it are blended again.
+func (m *GogiCanvas) FlattenLayers() bool {
+	lowest := len(m.layers)
+	for i, layer := range m.layers {
+		if layer.dirty {
+			lowest = i
+			break
+		}
+	}
+
+	if !m.layersDirty && lowest == len(m.layers) {
+		return false
+	}
+
+	if lowest < m.layerBaseCount || len(m.layerBase) != m.bufferSize {
+		m.layerBaseCount = 0
+	}
+
+	// when only the canvas needs redrawing, like after a page flip, the cache is used as is
+	// rather than growing it with layers that may well change next frame
+	target := lowest
+	if lowest == len(m.layers) {
+		target = m.layerBaseCount
+	}
+
+	if target > m.layerBaseCount {
+		if len(m.layerBase) != m.bufferSize {
+			m.layerBase = make([]uint8, m.bufferSize)
+		}
+		if m.layerBaseCount == 0 {
+			clear(m.layerBase)
+		}
+
+		for _, layer := range m.layers[m.layerBaseCount:target] {
+			m.compositeLayer(m.layerBase, layer)
+		}
+		m.layerBaseCount = target
+	}
+
+	if m.layerBaseCount > 0 {
+		copy(m.pixelBuffer, m.layerBase)
+		m.MarkAllDirty()
+	} else {
+		m.ClearBuffer()
+	}
+
+	for _, layer := range m.layers[m.layerBaseCount:] {
+		m.compositeLayer(m.pixelBuffer, layer)
+	}
+
+	for _, layer := range m.layers {
+		layer.dirty = false
+	}
+
+	m.layersDirty = false
+	return true
+}
+
+// ------------------------------------------------------------------------------------------------
+// invalidateLayerBase drops the cached composite if the stack changed at or below index.
+func (m *GogiCanvas) invalidateLayerBase(index int) {
+	if index < m.layerBaseCount {
+		m.layerBaseCount = 0
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// compositeLayer blends a visible layer into dst, a buffer the size of the canvas.
+func (m *GogiCanvas) compositeLayer(dst []uint8, layer *Layer) {
+	if !layer.visible || layer.opacity == 0 {
+		return
+	}
+
+	for ly := range layer.buffer.Height() {
+		cy := ly + layer.offsetY
+		if cy < 0 || cy >= m.height {
+			continue
+		}
+
+		for lx := range layer.buffer.Width() {
+			cx := lx + layer.offsetX
+			if cx < 0 || cx >= m.width {
+				continue
+			}
+
+			src := layer.buffer.GetPixel(lx, ly)
+			if src.A == 0 {
+				continue
+			}
+
+			offset := (cy*m.width + cx) * colour.BYTES_PER_PIXEL
+			below := colour.Colour{
+				R: dst[offset],
+				G: dst[offset+1],
+				B: dst[offset+2],
+				A: dst[offset+3],
+			}
+
+			out := compositeLayerPixel(src, below, layer.opacity, layer.blendMode)
+			dst[offset] = out.R
+			dst[offset+1] = out.G
+			dst[offset+2] = out.B
+			dst[offset+3] = out.A
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// compositeLayerPixel blends src over dst using the blend mode, with the source alpha
+// scaled by the layer opacity.
+func compositeLayerPixel(src, dst colour.Colour, opacity uint8, mode BlendMode) colour.Colour {
+	alpha := uint32(src.A) * uint32(opacity) / 255
+	if alpha == 0 {
+		return dst
+	}
+
+	blended := colour.Colour{
+		R: blendModeComponent(src.R, dst.R, mode),
+		G: blendModeComponent(src.G, dst.G, mode),
+		B: blendModeComponent(src.B, dst.B, mode),
+	}
+
+	inverse := 255 - alpha
+
+	return colour.Colour{
+		R: uint8((uint32(blended.R)*alpha + uint32(dst.R)*inverse) / 255),
+		G: uint8((uint32(blended.G)*alpha + uint32(dst.G)*inverse) / 255),
+		B: uint8((uint32(blended.B)*alpha + uint32(dst.B)*inverse) / 255),
+		A: uint8(alpha + uint32(dst.A)*inverse/255),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// blendModeComponent combines a single colour component according to the blend mode.
+func blendModeComponent(src, dst uint8, mode BlendMode) uint8 {
+	s, d := uint32(src), uint32(dst)
+
+	switch mode {
+	case BlendAdd:
+		return uint8(min(255, s+d))
+	case BlendMultiply:
+		return uint8(s * d / 255)
+	case BlendScreen:
+		return uint8(255 - (255-s)*(255-d)/255)
+	default:
+		return src
+	}
+}
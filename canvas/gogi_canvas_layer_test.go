@@ -0,0 +1,164 @@
+package canvas
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestFlattenLayersOrderAndOffset(t *testing.T) {
+	canvas := NewCanvas(10, 10)
+	red := colour.NewColour(255, 0, 0, 255)
+	blue := colour.NewColour(0, 0, 255, 255)
+
+	background := canvas.AddLayer(10, 10)
+	background.ColourPutPixel(5, 5, red)
+	background.ColourPutPixel(1, 1, red)
+
+	hud := canvas.AddLayer(2, 2)
+	hud.ColourPutPixel(0, 0, blue)
+	hud.SetOffset(5, 5)
+
+	if !canvas.FlattenLayers() {
+		t.Fatal("Expected the first flatten to composite the layers")
+	}
+
+	if pixel := canvas.GetPixel(5, 5); pixel != blue {
+		t.Errorf("Expected top layer to cover the pixel at (5, 5), but got %v", pixel)
+	}
+
+	if pixel := canvas.GetPixel(1, 1); pixel != red {
+		t.Errorf("Expected background pixel at (1, 1) to be red, but got %v", pixel)
+	}
+
+	// moving the hud to the bottom should reveal the background
+	canvas.MoveLayer(hud, 0)
+	canvas.FlattenLayers()
+
+	if pixel := canvas.GetPixel(5, 5); pixel != red {
+		t.Errorf("Expected background to cover the hud at (5, 5), but got %v", pixel)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFlattenLayersDirtyTracking(t *testing.T) {
+	canvas := NewCanvas(4, 4)
+	layer := canvas.AddLayer(4, 4)
+
+	if !canvas.FlattenLayers() {
+		t.Error("Expected a new layer to trigger compositing")
+	}
+
+	if canvas.FlattenLayers() {
+		t.Error("Expected no compositing when nothing changed")
+	}
+
+	layer.SetOpacity(layer.Opacity())
+	if canvas.FlattenLayers() {
+		t.Error("Expected setting the same opacity to leave the layer clean")
+	}
+
+	layer.SetVisible(false)
+	if !canvas.FlattenLayers() {
+		t.Error("Expected hiding a layer to trigger compositing")
+	}
+
+	canvas.RemoveLayer(layer)
+	if !canvas.FlattenLayers() {
+		t.Error("Expected removing a layer to trigger compositing")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFlattenLayersKeepsCleanLayersComposited(t *testing.T) {
+	red, green, blue := colour.NewColour(255, 0, 0, 255), colour.NewColour(0, 255, 0, 255), colour.NewColour(0, 0, 255, 255)
+
+	canvas := NewCanvas(4, 4)
+	background := canvas.AddLayer(4, 4)
+	sprite := canvas.AddLayer(4, 4)
+	background.ColourPutPixel(0, 0, red)
+	canvas.FlattenLayers()
+
+	// the first frame with only the sprite changing caches the background
+	sprite.ColourPutPixel(2, 2, blue)
+	canvas.FlattenLayers()
+
+	// change the background's buffer behind its back, so re-blending it would show green
+	background.Buffer().ColourPutPixel(0, 0, green)
+	sprite.ColourPutPixel(1, 1, blue)
+	canvas.FlattenLayers()
+
+	if pixel := canvas.GetPixel(0, 0); pixel != red {
+		t.Errorf("Expected the clean background to come from the cache, but got %v", pixel)
+	}
+	if pixel := canvas.GetPixel(1, 1); pixel != blue {
+		t.Errorf("Expected the dirty sprite layer to be composited, but got %v", pixel)
+	}
+
+	// once the background is marked dirty it is blended again
+	background.MarkDirty()
+	canvas.FlattenLayers()
+	if pixel := canvas.GetPixel(0, 0); pixel != green {
+		t.Errorf("Expected the dirty background to be composited, but got %v", pixel)
+	}
+
+	// removing the sprite redraws the canvas from the cache
+	canvas.RemoveLayer(sprite)
+	canvas.FlattenLayers()
+	if pixel := canvas.GetPixel(1, 1); pixel != (colour.Colour{}) {
+		t.Errorf("Expected the removed sprite to be gone, but got %v", pixel)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFlattenLayersOpacityAndHidden(t *testing.T) {
+	canvas := NewCanvas(4, 4)
+	white := colour.NewColourWhite()
+
+	base := canvas.AddLayer(4, 4)
+	base.ColourPutPixel(0, 0, colour.NewColourBlack())
+
+	top := canvas.AddLayer(4, 4)
+	top.ColourPutPixel(0, 0, white)
+	top.SetOpacity(128)
+
+	canvas.FlattenLayers()
+
+	if pixel := canvas.GetPixel(0, 0); pixel.R != 128 || pixel.A != 255 {
+		t.Errorf("Expected half opaque white over black to be grey, but got %v", pixel)
+	}
+
+	top.SetVisible(false)
+	canvas.FlattenLayers()
+
+	if pixel := canvas.GetPixel(0, 0); pixel.R != 0 {
+		t.Errorf("Expected hidden layer to be skipped, but got %v", pixel)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestBlendModeComponent(t *testing.T) {
+	tests := []struct {
+		mode     BlendMode
+		src, dst uint8
+		expected uint8
+		name     string
+	}{
+		{mode: BlendNormal, src: 100, dst: 200, expected: 100, name: "Normal"},
+		{mode: BlendAdd, src: 100, dst: 200, expected: 255, name: "Add saturates"},
+		{mode: BlendAdd, src: 10, dst: 20, expected: 30, name: "Add"},
+		{mode: BlendMultiply, src: 255, dst: 128, expected: 128, name: "Multiply by white"},
+		{mode: BlendScreen, src: 0, dst: 128, expected: 128, name: "Screen with black"},
+		{mode: BlendScreen, src: 255, dst: 128, expected: 255, name: "Screen with white"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := blendModeComponent(tt.src, tt.dst, tt.mode)
+			if got != tt.expected {
+				t.Errorf("blendModeComponent(%d, %d) = %d; want %d", tt.src, tt.dst, got, tt.expected)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ package canvas
 // DrawLine draws a line between (x0, y0) and (x1, y1) using Bresenham's algorithm.
 // It uses only integer arithmetic.
 func (m *GogiCanvas) DrawLine(x0, y0, x1, y1 int) {
+	m.markDirty(min(x0, x1), min(y0, y1), abs(x1-x0)+1, abs(y1-y0)+1)
+
 	// Determine if the line is steep (more vertical than horizontal)
 	// This helps in swapping x and y coordinates to always iterate along the major axis.
 	steep := abs(y1-y0) > abs(x1-x0)
@@ -45,9 +47,9 @@ func (m *GogiCanvas) DrawLine(x0, y0, x1, y1 int) {
 		// If the line was steep, swap x and y back before setting the pixel.
 		// This translates the calculated (x, y) back to the original coordinate system.
 		if steep {
-			m.PutPixel(y, x)
+			m.colourPutPixel(y, x, m.activeColour)
 		} else {
-			m.PutPixel(x, y)
+			m.colourPutPixel(x, y, m.activeColour)
 		}
 
 		// Update the error term.
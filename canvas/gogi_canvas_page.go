@@ -0,0 +1,92 @@
+package canvas
+
+// Page handling mirrors the BGI setactivepage/setvisualpage model. All drawing goes to the
+// active page while the host reads the visual page, so a frame that takes a while to
+// render never shows up half drawn.
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) PageCount() int {
+	return len(m.pages)
+}
+
+// ------------------------------------------------------------------------------------------------
+// ActivePage returns the page that drawing functions write to.
+func (m *GogiCanvas) ActivePage() int {
+	return m.activePage
+}
+
+// ------------------------------------------------------------------------------------------------
+// VisualPage returns the page exposed through GetBufferPointer.
+func (m *GogiCanvas) VisualPage() int {
+	return m.visualPage
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetActivePage selects the page to draw on. Invalid page numbers are ignored.
+func (m *GogiCanvas) SetActivePage(page int) {
+	if page < 0 || page >= len(m.pages) || page == m.activePage {
+		return
+	}
+
+	m.activePage = page
+	m.pixelBuffer = m.pages[page]
+
+	// the flattened layers live on the previous page
+	m.layersDirty = true
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetVisualPage selects the page the host displays. Invalid page numbers are ignored.
+func (m *GogiCanvas) SetVisualPage(page int) {
+	if page < 0 || page >= len(m.pages) || page == m.visualPage {
+		return
+	}
+
+	m.visualPage = page
+
+	// every pixel the host sees potentially changed
+	m.MarkAllDirty()
+}
+
+// ------------------------------------------------------------------------------------------------
+// Present publishes the active page as the completed frame and moves drawing on to the
+// next page in turn. The new active page keeps whatever it held before, so redraw or clear
+// it before presenting again. With a single page this does nothing.
+func (m *GogiCanvas) Present() {
+	if len(m.pages) == 1 {
+		return
+	}
+
+	completed := m.activePage
+	m.SetActivePage((completed + 1) % len(m.pages))
+	m.SetVisualPage(completed)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Flip swaps the active and visual pages, the classic BGI page flip. With two pages this is
+// the same as Present. When both already point at the same page, Flip behaves like Present.
+func (m *GogiCanvas) Flip() {
+	if m.activePage == m.visualPage {
+		m.Present()
+		return
+	}
+
+	m.activePage, m.visualPage = m.visualPage, m.activePage
+	m.pixelBuffer = m.pages[m.activePage]
+	m.layersDirty = true
+	m.MarkAllDirty()
+}
+
+// ------------------------------------------------------------------------------------------------
+// CopyVisibleBuffer copies the visual page into dst, growing it if needed, and returns it.
+// Native display backends use this to read the last completed frame without allocating a
+// new buffer every time.
+func (m *GogiCanvas) CopyVisibleBuffer(dst []uint8) []uint8 {
+	if cap(dst) < m.bufferSize {
+		dst = make([]uint8, m.bufferSize)
+	}
+	dst = dst[:m.bufferSize]
+
+	copy(dst, m.pages[m.visualPage])
+	return dst
+}
@@ -0,0 +1,109 @@
+package canvas
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestSinglePageCanvas(t *testing.T) {
+	canvas := NewCanvas(4, 4)
+	pointer := canvas.GetBufferPointer()
+
+	canvas.Present()
+	canvas.Flip()
+
+	if canvas.GetBufferPointer() != pointer || canvas.ActivePage() != 0 || canvas.VisualPage() != 0 {
+		t.Error("Expected a single page canvas to ignore Present and Flip")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDoubleBufferingPresent(t *testing.T) {
+	canvas := NewCanvasWithPages(4, 4, 2)
+	red := colour.NewColour(255, 0, 0, 255)
+
+	canvas.ColourPutPixel(1, 1, red)
+	canvas.Present()
+
+	if canvas.VisualPage() != 0 || canvas.ActivePage() != 1 {
+		t.Fatalf("Expected visual page 0 and active page 1, got %d and %d", canvas.VisualPage(), canvas.ActivePage())
+	}
+
+	if canvas.GetBufferPointer() != uintptr(unsafe.Pointer(&canvas.pages[0][0])) {
+		t.Error("Expected the buffer pointer to address the completed frame")
+	}
+
+	// the back page is untouched, so the red pixel is only on the visual page
+	if pixel := canvas.GetPixel(1, 1); !pixel.IsEmpty() {
+		t.Errorf("Expected the new active page to be empty, but got %v", pixel)
+	}
+
+	if len(canvas.DirtyRects()) != 1 || canvas.DirtyRects()[0] != canvas.Bounds() {
+		t.Error("Expected presenting a new page to mark the whole canvas dirty")
+	}
+
+	canvas.Present()
+	if canvas.VisualPage() != 1 || canvas.ActivePage() != 0 {
+		t.Errorf("Expected pages to swap back, got visual %d and active %d", canvas.VisualPage(), canvas.ActivePage())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTripleBufferingCyclesPages(t *testing.T) {
+	canvas := NewCanvasWithPages(4, 4, 3)
+
+	for frame := range 6 {
+		canvas.Present()
+
+		if canvas.VisualPage() != frame%3 || canvas.ActivePage() != (frame+1)%3 {
+			t.Errorf("Frame %d: unexpected visual page %d and active page %d", frame, canvas.VisualPage(), canvas.ActivePage())
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSetActiveAndVisualPage(t *testing.T) {
+	canvas := NewCanvasWithPages(4, 4, 2)
+	blue := colour.NewColour(0, 0, 255, 255)
+
+	canvas.SetActivePage(1)
+	canvas.ColourPutPixel(0, 0, blue)
+	canvas.SetActivePage(5)
+
+	if canvas.ActivePage() != 1 {
+		t.Error("Expected invalid page numbers to be ignored")
+	}
+
+	canvas.Flip()
+	if canvas.ActivePage() != 0 || canvas.VisualPage() != 1 {
+		t.Errorf("Expected Flip to swap pages, got active %d and visual %d", canvas.ActivePage(), canvas.VisualPage())
+	}
+
+	canvas.SetActivePage(1)
+	if pixel := canvas.GetPixel(0, 0); pixel != blue {
+		t.Errorf("Expected drawing to have gone to page 1, but got %v", pixel)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestCopyVisibleBuffer(t *testing.T) {
+	canvas := NewCanvasWithPages(2, 2, 2)
+	red := colour.NewColour(255, 0, 0, 255)
+
+	canvas.ColourPutPixel(1, 0, red)
+	canvas.Present()
+	canvas.ColourPutPixel(0, 0, red)
+
+	visible := canvas.CopyVisibleBuffer(nil)
+	if len(visible) != 16 || visible[4] != 255 || visible[0] != 0 {
+		t.Errorf("Expected only the presented pixel in the visible copy, got %v", visible)
+	}
+
+	reused := canvas.CopyVisibleBuffer(visible)
+	if &reused[0] != &visible[0] {
+		t.Error("Expected a large enough buffer to be reused")
+	}
+}
@@ -0,0 +1,273 @@
+package canvas
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/curve"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// subpath is a run of connected points, flattened from the lines and curves that built it.
+type subpath struct {
+	points []vecmath.Vec2
+	closed bool
+}
+
+// ------------------------------------------------------------------------------------------------
+// Path is a shape made of lines, curves and arcs, built up like an HTML canvas path and then
+// filled or stroked with FillPath and StrokePath. Curves are flattened as they are added.
+type Path struct {
+	subpaths []subpath
+	start    vecmath.Vec2
+	current  vecmath.Vec2
+	started  bool
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewPath() *Path {
+	return &Path{}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Reset empties the path.
+func (p *Path) Reset() {
+	*p = Path{}
+}
+
+// ------------------------------------------------------------------------------------------------
+// MoveTo starts a new subpath at x, y.
+func (p *Path) MoveTo(x, y float64) {
+	p.start = vecmath.Vec2{X: x, Y: y}
+	p.current = p.start
+	p.started = true
+	p.subpaths = append(p.subpaths, subpath{points: []vecmath.Vec2{p.start}})
+}
+
+// ------------------------------------------------------------------------------------------------
+// LineTo adds a straight line from the current point to x, y. Without a current point it
+// behaves like MoveTo.
+func (p *Path) LineTo(x, y float64) {
+	p.lineTo(vecmath.Vec2{X: x, Y: y})
+}
+
+// ------------------------------------------------------------------------------------------------
+// QuadTo adds a quadratic Bezier curve to x, y, bending towards cx, cy.
+func (p *Path) QuadTo(cx, cy, x, y float64) {
+	p.ensureStarted(cx, cy)
+	p.addCurve(curve.Quadratic{P0: p.current, P1: vecmath.Vec2{X: cx, Y: cy}, P2: vecmath.Vec2{X: x, Y: y}})
+}
+
+// ------------------------------------------------------------------------------------------------
+// CubicTo adds a cubic Bezier curve to x, y, leaving towards c1 and arriving from c2.
+func (p *Path) CubicTo(c1x, c1y, c2x, c2y, x, y float64) {
+	p.ensureStarted(c1x, c1y)
+	p.addCurve(curve.Cubic{
+		P0: p.current,
+		P1: vecmath.Vec2{X: c1x, Y: c1y},
+		P2: vecmath.Vec2{X: c2x, Y: c2y},
+		P3: vecmath.Vec2{X: x, Y: y},
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+// ArcTo rounds the corner the current point, x1, y1 and x2, y2 would make with a circular arc
+// of the given radius, like the HTML canvas arcTo. It adds a line to where the arc starts and
+// then the arc, ending on the line towards x2, y2.
+func (p *Path) ArcTo(x1, y1, x2, y2, radius float64) {
+	if !p.started {
+		p.MoveTo(x1, y1)
+	}
+
+	corner, end := vecmath.Vec2{X: x1, Y: y1}, vecmath.Vec2{X: x2, Y: y2}
+	in, out := p.current.Sub(corner), end.Sub(corner)
+
+	// without a proper corner there is nothing to round
+	if radius <= 0 || in.LengthSquared() == 0 || out.LengthSquared() == 0 || math.Abs(in.Cross(out)) < 1e-9 {
+		p.lineTo(corner)
+		return
+	}
+
+	in, out = in.Normalise(), out.Normalise()
+	halfAngle := math.Acos(math.Max(-1, math.Min(1, in.Dot(out)))) / 2
+
+	// the circle touches both lines this far from the corner
+	tangent := radius / math.Tan(halfAngle)
+	centre := corner.Add(in.Add(out).Normalise().Scale(radius / math.Sin(halfAngle)))
+	from, to := corner.Add(in.Scale(tangent)), corner.Add(out.Scale(tangent))
+
+	startAngle := from.Sub(centre).Angle()
+	sweep := to.Sub(centre).Angle() - startAngle
+	// take the short way round
+	if sweep > math.Pi {
+		sweep -= 2 * math.Pi
+	} else if sweep < -math.Pi {
+		sweep += 2 * math.Pi
+	}
+
+	p.lineTo(from)
+	p.addArc(centre, radius, startAngle, sweep)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Arc adds a circular arc around cx, cy from startAngle to endAngle, in radians, clockwise on
+// screen unless anticlockwise is set. A line joins the current point to the start of the arc.
+func (p *Path) Arc(cx, cy, radius, startAngle, endAngle float64, anticlockwise bool) {
+	centre := vecmath.Vec2{X: cx, Y: cy}
+	begin := centre.Add(vecmath.FromAngle(startAngle).Scale(radius))
+
+	sweep := endAngle - startAngle
+	switch {
+	case !anticlockwise && sweep >= 2*math.Pi:
+		sweep = 2 * math.Pi
+	case anticlockwise && sweep <= -2*math.Pi:
+		sweep = -2 * math.Pi
+	case !anticlockwise:
+		sweep = math.Mod(math.Mod(sweep, 2*math.Pi)+2*math.Pi, 2*math.Pi)
+	default:
+		sweep = -math.Mod(math.Mod(-sweep, 2*math.Pi)+2*math.Pi, 2*math.Pi)
+	}
+
+	p.lineTo(begin)
+	p.addArc(centre, radius, startAngle, sweep)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Close joins the current point back to the start of the subpath. Anything added after it
+// starts a new subpath at the same place.
+func (p *Path) Close() {
+	if !p.started {
+		return
+	}
+
+	last := &p.subpaths[len(p.subpaths)-1]
+	last.closed = true
+
+	p.current = p.start
+	p.subpaths = append(p.subpaths, subpath{points: []vecmath.Vec2{p.start}})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *Path) lineTo(to vecmath.Vec2) {
+	if !p.started {
+		p.MoveTo(to.X, to.Y)
+		return
+	}
+
+	last := &p.subpaths[len(p.subpaths)-1]
+	last.points = append(last.points, to)
+	p.current = to
+}
+
+// ------------------------------------------------------------------------------------------------
+// ensureStarted moves to x, y when there is no current point, as the HTML canvas does.
+func (p *Path) ensureStarted(x, y float64) {
+	if !p.started {
+		p.MoveTo(x, y)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *Path) addCurve(c curve.Curve) {
+	// the first point is already the current point
+	for _, point := range curve.Flatten(c, CURVE_TOLERANCE)[1:] {
+		p.lineTo(point)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// addArc adds an arc from the current point, which must be on the circle at startAngle, using
+// a cubic Bezier for every quarter turn or less.
+func (p *Path) addArc(centre vecmath.Vec2, radius, startAngle, sweep float64) {
+	pieces := max(1, int(math.Ceil(math.Abs(sweep)/(math.Pi/2)-1e-9)))
+	step := sweep / float64(pieces)
+
+	// how far the control points sit along the tangents
+	k := 4.0 / 3 * math.Tan(step/4) * radius
+
+	angle := startAngle
+	for range pieces {
+		from, to := vecmath.FromAngle(angle), vecmath.FromAngle(angle+step)
+		p.addCurve(curve.Cubic{
+			P0: centre.Add(from.Scale(radius)),
+			P1: centre.Add(from.Scale(radius)).Add(from.Perpendicular().Scale(k)),
+			P2: centre.Add(to.Scale(radius)).Sub(to.Perpendicular().Scale(k)),
+			P3: centre.Add(to.Scale(radius)),
+		})
+		angle += step
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// polylines returns the points of every subpath with repeated points removed, leaving out
+// subpaths that are only a single point.
+func (p *Path) polylines() []subpath {
+	var result []subpath
+	for _, sp := range p.subpaths {
+		points := withoutRepeats(sp.points)
+
+		// a closed subpath ending where it started closes on its own
+		if sp.closed && len(points) > 2 && points[len(points)-1] == points[0] {
+			points = points[:len(points)-1]
+		}
+
+		if len(points) > 1 {
+			result = append(result, subpath{points: points, closed: sp.closed})
+		}
+	}
+	return result
+}
+
+// ------------------------------------------------------------------------------------------------
+// BeginPath empties the canvas's current path, ready for MoveTo, LineTo and friends, which
+// work like their Path counterparts. Draw it with Fill or Stroke.
+func (m *GogiCanvas) BeginPath() {
+	m.path.Reset()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) MoveTo(x, y float64) {
+	m.path.MoveTo(x, y)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) LineTo(x, y float64) {
+	m.path.LineTo(x, y)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) QuadTo(cx, cy, x, y float64) {
+	m.path.QuadTo(cx, cy, x, y)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) CubicTo(c1x, c1y, c2x, c2y, x, y float64) {
+	m.path.CubicTo(c1x, c1y, c2x, c2y, x, y)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) ArcTo(x1, y1, x2, y2, radius float64) {
+	m.path.ArcTo(x1, y1, x2, y2, radius)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) Arc(cx, cy, radius, startAngle, endAngle float64, anticlockwise bool) {
+	m.path.Arc(cx, cy, radius, startAngle, endAngle, anticlockwise)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *GogiCanvas) Close() {
+	m.path.Close()
+}
+
+// ------------------------------------------------------------------------------------------------
+// Fill fills the current path with the active colour.
+func (m *GogiCanvas) Fill(rule FillRule) {
+	m.FillPath(&m.path, rule)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Stroke draws the outline of the current path with the active colour.
+func (m *GogiCanvas) Stroke(options ...StrokeOption) {
+	m.StrokePath(&m.path, options...)
+}
@@ -0,0 +1,173 @@
+package canvas
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// StrokePath draws the outline of the path with the active colour. The default is a one pixel
+// line with mitered corners and flat ends, see the StrokeOption functions for others. Paths
+// are always anti-aliased.
+func (m *GogiCanvas) StrokePath(p *Path, options ...StrokeOption) {
+	style := newStrokeStyle(options)
+	if style.thickness == 0 {
+		return
+	}
+
+	var polygons [][]vecmath.Vec2
+	for _, sp := range p.polylines() {
+		if len(style.dashes) == 0 {
+			polygons = style.outline(polygons, sp.points, sp.closed)
+			continue
+		}
+
+		for _, piece := range style.dash(sp.points, sp.closed) {
+			polygons = style.outline(polygons, withoutRepeats(piece), false)
+		}
+	}
+
+	// every piece winds the same way, so the non-zero rule fills where any of them are
+	m.fillPolygons(polygons, FillNonZero, true)
+}
+
+// ------------------------------------------------------------------------------------------------
+// outline appends polygons covering the stroke of a polyline: a rectangle for every segment,
+// plus the joins between them and the caps on the ends of open lines.
+func (s strokeStyle) outline(polygons [][]vecmath.Vec2, points []vecmath.Vec2, closed bool) [][]vecmath.Vec2 {
+	halfWidth := s.thickness / 2
+
+	// a dash of no length still shows its caps
+	if len(points) == 1 {
+		if s.cap == CapRound {
+			polygons = append(polygons, circlePolygon(points[0], halfWidth))
+		}
+		return polygons
+	}
+
+	segments := len(points) - 1
+	if closed {
+		segments++
+	}
+
+	for i := range segments {
+		a, b := points[i], points[(i+1)%len(points)]
+		direction := b.Sub(a).Normalise()
+		side := direction.Perpendicular().Scale(halfWidth)
+
+		// square caps stretch the first and last segments
+		if !closed && s.cap == CapSquare {
+			if i == 0 {
+				a = a.Sub(direction.Scale(halfWidth))
+			}
+			if i == segments-1 {
+				b = b.Add(direction.Scale(halfWidth))
+			}
+		}
+
+		polygons = append(polygons, positive([]vecmath.Vec2{a.Add(side), b.Add(side), b.Sub(side), a.Sub(side)}))
+	}
+
+	for i := range points {
+		if !closed && (i == 0 || i == len(points)-1) {
+			continue
+		}
+
+		previous, next := points[(i+len(points)-1)%len(points)], points[(i+1)%len(points)]
+		if join := s.joinPolygon(points[i], points[i].Sub(previous).Normalise(), next.Sub(points[i]).Normalise()); join != nil {
+			polygons = append(polygons, join)
+		}
+	}
+
+	if !closed && s.cap == CapRound {
+		polygons = append(polygons, circlePolygon(points[0], halfWidth), circlePolygon(points[len(points)-1], halfWidth))
+	}
+
+	return polygons
+}
+
+// ------------------------------------------------------------------------------------------------
+// joinPolygon fills the gap on the outside of a corner at v, where the line arrives heading
+// in and leaves heading out. Straight on needs nothing.
+func (s strokeStyle) joinPolygon(v, in, out vecmath.Vec2) []vecmath.Vec2 {
+	halfWidth := s.thickness / 2
+	turn := in.Cross(out)
+
+	if s.join == JoinRound {
+		if math.Abs(turn) < 1e-9 && in.Dot(out) > 0 {
+			return nil
+		}
+		return circlePolygon(v, halfWidth)
+	}
+
+	if math.Abs(turn) < 1e-9 {
+		return nil
+	}
+
+	// the outside of the corner is on the opposite side to the way it turns
+	outside := halfWidth
+	if turn > 0 {
+		outside = -halfWidth
+	}
+	from := v.Add(in.Perpendicular().Scale(outside))
+	to := v.Add(out.Perpendicular().Scale(outside))
+
+	if s.join == JoinMiter {
+		bisector := from.Add(to).Sub(v.Scale(2)).Normalise()
+		cosHalf := bisector.Dot(from.Sub(v)) / halfWidth
+
+		// the miter is 1/cosHalf times the thickness long
+		if cosHalf > 0 && 1/cosHalf <= s.miterLimit {
+			tip := v.Add(bisector.Scale(halfWidth / cosHalf))
+			return positive([]vecmath.Vec2{v, from, tip, to})
+		}
+	}
+
+	return positive([]vecmath.Vec2{v, from, to})
+}
+
+// ------------------------------------------------------------------------------------------------
+// circlePolygon returns a circle with enough sides to stay within CURVE_TOLERANCE of round.
+func circlePolygon(centre vecmath.Vec2, radius float64) []vecmath.Vec2 {
+	sides := 8
+	if radius > CURVE_TOLERANCE {
+		step := 2 * math.Acos(1-CURVE_TOLERANCE/radius)
+		sides = max(sides, int(math.Ceil(2*math.Pi/step)))
+	}
+
+	polygon := make([]vecmath.Vec2, sides)
+	for i := range polygon {
+		polygon[i] = centre.Add(vecmath.FromAngle(2 * math.Pi * float64(i) / float64(sides)).Scale(radius))
+	}
+	return polygon
+}
+
+// ------------------------------------------------------------------------------------------------
+// positive reverses the polygon if needed so it winds clockwise on screen. Pieces that all
+// wind the same way add up where they overlap, instead of cancelling out.
+func positive(polygon []vecmath.Vec2) []vecmath.Vec2 {
+	area := 0.0
+	for i, a := range polygon {
+		area += a.Cross(polygon[(i+1)%len(polygon)])
+	}
+
+	if area < 0 {
+		for i, j := 0, len(polygon)-1; i < j; i, j = i+1, j-1 {
+			polygon[i], polygon[j] = polygon[j], polygon[i]
+		}
+	}
+	return polygon
+}
+
+// ------------------------------------------------------------------------------------------------
+// withoutRepeats drops points that repeat the one before, which would have no direction.
+func withoutRepeats(points []vecmath.Vec2) []vecmath.Vec2 {
+	result := make([]vecmath.Vec2, 0, len(points))
+	for _, p := range points {
+		if len(result) == 0 || result[len(result)-1] != p {
+			result = append(result, p)
+		}
+	}
+	return result
+}
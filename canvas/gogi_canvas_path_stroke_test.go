@@ -0,0 +1,189 @@
+package canvas
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// strokeCorner strokes an open right angle, from (5, 20) across to (20, 20) and down to
+// (20, 35), six pixels thick.
+func strokeCorner(options ...StrokeOption) *GogiCanvas {
+	c := NewCanvas(40, 40)
+	c.SetColour(colour.NewColourWhite())
+	c.BeginPath()
+	c.MoveTo(5, 20)
+	c.LineTo(20, 20)
+	c.LineTo(20, 35)
+	c.Stroke(append([]StrokeOption{WithThickness(6)}, options...)...)
+	return c
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestStrokeJoins(t *testing.T) {
+	// the miter tip is at (23, 17), beyond the bevel's cut from (20, 17) to (23, 20), and the
+	// round join reaches part of the way there
+	tests := []struct {
+		name      string
+		join      LineJoin
+		low, high uint8
+	}{
+		{"miter", JoinMiter, 255, 255},
+		{"bevel", JoinBevel, 0, 0},
+		{"round", JoinRound, 1, 254},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := strokeCorner(WithJoin(tt.join))
+
+			if got := c.GetPixel(22, 18).A; got < tt.low || got > tt.high {
+				t.Errorf("Expected alpha between %d and %d outside the corner, but got %d", tt.low, tt.high, got)
+			}
+			if got := c.GetPixel(20, 20).A; got != 255 {
+				t.Errorf("Expected the corner itself to be solid, but got %d", got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestStrokeMiterLimit(t *testing.T) {
+	sharp := func(limit float64) *GogiCanvas {
+		c := NewCanvas(60, 40)
+		c.SetColour(colour.NewColourWhite())
+		c.BeginPath()
+		c.MoveTo(5, 10)
+		c.LineTo(40, 20)
+		c.LineTo(5, 30)
+		c.Stroke(WithThickness(4), WithMiterLimit(limit))
+		return c
+	}
+
+	// the miter of this corner is about 3.6 times the thickness, reaching to x=47
+	if got := sharp(DEFAULT_MITER_LIMIT).GetPixel(44, 20).A; got != 255 {
+		t.Errorf("Expected the miter to reach x=44, but got alpha %d", got)
+	}
+	if got := sharp(2).GetPixel(44, 20).A; got != 0 {
+		t.Errorf("Expected the miter limit to bevel the corner, but got alpha %d", got)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestStrokeCaps(t *testing.T) {
+	// the line starts at x=5, so a square or round cap covers x=3, and only a square one
+	// fills the corner at (3, 18)
+	tests := []struct {
+		name                  string
+		cap                   LineCap
+		inside                uint8
+		cornerLow, cornerHigh uint8
+	}{
+		{"butt", CapButt, 0, 0, 0},
+		{"square", CapSquare, 255, 255, 255},
+		{"round", CapRound, 255, 1, 254},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := strokeCorner(WithCap(tt.cap))
+
+			if got := c.GetPixel(3, 20).A; got != tt.inside {
+				t.Errorf("Expected alpha %d before the start, but got %d", tt.inside, got)
+			}
+			if got := c.GetPixel(3, 18).A; got < tt.cornerLow || got > tt.cornerHigh {
+				t.Errorf("Expected alpha between %d and %d in the corner of the cap, but got %d", tt.cornerLow, tt.cornerHigh, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestStrokeDashes(t *testing.T) {
+	c := NewCanvas(40, 10)
+	c.SetColour(colour.NewColourWhite())
+	c.BeginPath()
+	c.MoveTo(0.5, 5)
+	c.LineTo(40.5, 5)
+	c.Stroke(WithThickness(2), WithDash(0, 5, 3))
+
+	// dashes cover 0.5 to 5.5, 8.5 to 13.5, 16.5 to 21.5 and so on
+	for x := range 40 {
+		drawn := (x-1)%8 < 5 && x >= 1
+		if got := c.GetPixel(x, 5).A == 255; got != drawn {
+			t.Errorf("Expected pixel %d drawn to be %v, but got alpha %d", x, drawn, c.GetPixel(x, 5).A)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestStrokeOverlapsDontCancel(t *testing.T) {
+	c := NewCanvas(30, 30)
+	c.SetColour(colour.NewColourWhite())
+
+	// a figure of eight, crossing itself in the middle
+	c.BeginPath()
+	c.MoveTo(5, 5)
+	c.LineTo(25, 25)
+	c.LineTo(25, 5)
+	c.LineTo(5, 25)
+	c.Close()
+	c.Stroke(WithThickness(4), WithJoin(JoinRound))
+
+	if got := c.GetPixel(15, 15).A; got != 255 {
+		t.Errorf("Expected the crossing to be solid, but got alpha %d", got)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDash(t *testing.T) {
+	line := []vecmath.Vec2{{}, {X: 10}, {X: 10, Y: 10}}
+
+	tests := []struct {
+		name     string
+		style    strokeStyle
+		closed   bool
+		expected [][]vecmath.Vec2
+	}{
+		{"no pattern", newStrokeStyle(nil), false, [][]vecmath.Vec2{line}},
+		{"across a corner", newStrokeStyle([]StrokeOption{WithDash(0, 8, 4)}), false, [][]vecmath.Vec2{
+			{{}, {X: 8}},
+			{{X: 10, Y: 2}, {X: 10, Y: 10}},
+		}},
+		{"odd pattern repeats", newStrokeStyle([]StrokeOption{WithDash(0, 6)}), false, [][]vecmath.Vec2{
+			{{}, {X: 6}},
+			{{X: 10, Y: 2}, {X: 10, Y: 8}},
+		}},
+		// starting 10 into the pattern leaves 2 of the first gap
+		{"offset", newStrokeStyle([]StrokeOption{WithDash(10, 8, 4)}), false, [][]vecmath.Vec2{
+			{{X: 2}, {X: 10}},
+			{{X: 10, Y: 4}, {X: 10, Y: 10}},
+		}},
+		{"closed goes round", newStrokeStyle([]StrokeOption{WithDash(0, 25, 100)}), true, [][]vecmath.Vec2{
+			{{}, {X: 10}, {X: 10, Y: 10}, {X: 10 - 5/math.Sqrt2, Y: 10 - 5/math.Sqrt2}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.style.dash(line, tt.closed)
+
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Expected %v, but got %v", tt.expected, got)
+			}
+			for i := range got {
+				if len(got[i]) != len(tt.expected[i]) {
+					t.Fatalf("Expected %v, but got %v", tt.expected, got)
+				}
+				for j := range got[i] {
+					if got[i][j].Distance(tt.expected[i][j]) > 1e-9 {
+						t.Errorf("Expected %v, but got %v", tt.expected, got)
+					}
+				}
+			}
+		})
+	}
+}
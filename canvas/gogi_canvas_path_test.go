@@ -0,0 +1,253 @@
+package canvas
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestPathBuilding(t *testing.T) {
+	tests := []struct {
+		name     string
+		build    func(p *Path)
+		expected []subpath
+	}{
+		{"lines", func(p *Path) {
+			p.MoveTo(1, 2)
+			p.LineTo(3, 4)
+			p.LineTo(5, 2)
+		}, []subpath{{points: []vecmath.Vec2{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 2}}}}},
+		{"line without a start", func(p *Path) {
+			p.LineTo(3, 4)
+			p.LineTo(5, 2)
+		}, []subpath{{points: []vecmath.Vec2{{X: 3, Y: 4}, {X: 5, Y: 2}}}}},
+		{"close starts again at the start", func(p *Path) {
+			p.MoveTo(0, 0)
+			p.LineTo(4, 0)
+			p.LineTo(4, 4)
+			p.Close()
+			p.LineTo(0, 4)
+		}, []subpath{
+			{points: []vecmath.Vec2{{}, {X: 4}, {X: 4, Y: 4}}, closed: true},
+			{points: []vecmath.Vec2{{}, {Y: 4}}},
+		}},
+		{"repeated points and lone moves are dropped", func(p *Path) {
+			p.MoveTo(9, 9)
+			p.MoveTo(0, 0)
+			p.LineTo(0, 0)
+			p.LineTo(2, 0)
+			p.LineTo(2, 0)
+		}, []subpath{{points: []vecmath.Vec2{{}, {X: 2}}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPath()
+			tt.build(p)
+			got := p.polylines()
+
+			if len(got) != len(tt.expected) {
+				t.Fatalf("Expected %d subpaths, but got %v", len(tt.expected), got)
+			}
+			for i := range got {
+				if got[i].closed != tt.expected[i].closed || len(got[i].points) != len(tt.expected[i].points) {
+					t.Fatalf("Expected subpath %v, but got %v", tt.expected[i], got[i])
+				}
+				for j := range got[i].points {
+					if got[i].points[j] != tt.expected[i].points[j] {
+						t.Errorf("Expected subpath %v, but got %v", tt.expected[i], got[i])
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPathCurvesEndWhereAsked(t *testing.T) {
+	p := NewPath()
+	p.MoveTo(0, 0)
+	p.QuadTo(10, 20, 20, 0)
+	p.CubicTo(30, 10, 40, -10, 50, 0)
+
+	points := p.polylines()[0].points
+	if len(points) < 4 {
+		t.Fatalf("Expected the curves to be flattened into several points, but got %v", points)
+	}
+	if last := points[len(points)-1]; last != (vecmath.Vec2{X: 50}) {
+		t.Errorf("Expected the path to end at (50, 0), but got %v", last)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPathArc(t *testing.T) {
+	centre := vecmath.Vec2{X: 50, Y: 50}
+
+	tests := []struct {
+		name          string
+		start, end    float64
+		anticlockwise bool
+		through       vecmath.Vec2
+	}{
+		{"quarter clockwise", 0, math.Pi / 2, false, vecmath.Vec2{X: 50 + 20*math.Sqrt2/2, Y: 50 + 20*math.Sqrt2/2}},
+		{"quarter anticlockwise goes the long way", 0, math.Pi / 2, true, vecmath.Vec2{X: 30, Y: 50}},
+		{"full circle", 0, 2 * math.Pi, false, vecmath.Vec2{X: 30, Y: 50}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewPath()
+			p.Arc(centre.X, centre.Y, 20, tt.start, tt.end, tt.anticlockwise)
+			points := p.polylines()[0].points
+
+			closest := math.Inf(1)
+			for _, point := range points {
+				if d := math.Abs(point.Distance(centre) - 20); d > CURVE_TOLERANCE+0.01 {
+					t.Fatalf("Expected every point on the circle, but %v is %v off", point, d)
+				}
+				closest = math.Min(closest, point.Distance(tt.through))
+			}
+
+			if closest > 2 {
+				t.Errorf("Expected the arc to pass %v, but it came no closer than %v", tt.through, closest)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPathArcTo(t *testing.T) {
+	// round the corner of an L shape with a radius of 10
+	p := NewPath()
+	p.MoveTo(0, 0)
+	p.ArcTo(40, 0, 40, 40, 10)
+
+	points := p.polylines()[0].points
+	if first := points[1]; first.Distance(vecmath.Vec2{X: 30}) > 1e-9 {
+		t.Errorf("Expected a line to where the arc starts at (30, 0), but got %v", first)
+	}
+	if last := points[len(points)-1]; last.Distance(vecmath.Vec2{X: 40, Y: 10}) > 1e-9 {
+		t.Errorf("Expected the arc to end at (40, 10), but got %v", last)
+	}
+
+	centre := vecmath.Vec2{X: 30, Y: 10}
+	for _, point := range points[1:] {
+		if d := math.Abs(point.Distance(centre) - 10); d > CURVE_TOLERANCE+0.01 {
+			t.Errorf("Expected %v to be on the rounded corner, but it is %v off", point, d)
+		}
+	}
+
+	// a straight line through the corner has nothing to round
+	p = NewPath()
+	p.MoveTo(0, 0)
+	p.ArcTo(10, 0, 20, 0, 5)
+	if got := p.polylines()[0].points; len(got) != 2 || got[1] != (vecmath.Vec2{X: 10}) {
+		t.Errorf("Expected a line to the corner, but got %v", got)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFillRules(t *testing.T) {
+	white := colour.NewColourWhite()
+
+	// two overlapping squares wound the same way
+	squares := func(c *GogiCanvas) {
+		c.BeginPath()
+		c.MoveTo(1.5, 1.5)
+		c.LineTo(11.5, 1.5)
+		c.LineTo(11.5, 11.5)
+		c.LineTo(1.5, 11.5)
+		c.Close()
+		c.MoveTo(6.5, 6.5)
+		c.LineTo(16.5, 6.5)
+		c.LineTo(16.5, 16.5)
+		c.LineTo(6.5, 16.5)
+		c.Close()
+	}
+
+	tests := []struct {
+		name        string
+		rule        FillRule
+		overlapSeen bool
+	}{
+		{"non-zero fills the overlap", FillNonZero, true},
+		{"even-odd leaves a hole", FillEvenOdd, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewCanvas(20, 20)
+			c.SetColour(white)
+			squares(c)
+			c.Fill(tt.rule)
+
+			if c.GetPixel(4, 4) != white || c.GetPixel(14, 14) != white {
+				t.Errorf("Expected both squares filled, but got %v and %v", c.GetPixel(4, 4), c.GetPixel(14, 14))
+			}
+			if got := c.GetPixel(9, 9) == white; got != tt.overlapSeen {
+				t.Errorf("Expected the overlap filled to be %v, but got %v", tt.overlapSeen, c.GetPixel(9, 9))
+			}
+			if got := c.GetPixel(15, 3); got != (colour.Colour{}) {
+				t.Errorf("Expected outside to stay empty, but got %v", got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFillAntiAliasing(t *testing.T) {
+	c := NewCanvas(20, 20)
+	c.SetColour(colour.NewColourWhite())
+
+	// the right edge runs through the middle of column 10, the bottom a quarter into row 12
+	c.BeginPath()
+	c.MoveTo(2.5, 2.5)
+	c.LineTo(10, 2.5)
+	c.LineTo(10, 11.75)
+	c.LineTo(2.5, 11.75)
+	c.Close()
+	c.Fill(FillNonZero)
+
+	tests := []struct {
+		name     string
+		x, y     int
+		expected uint8
+	}{
+		{"inside", 5, 5, 255},
+		{"half way across the right edge", 10, 5, 128},
+		{"a quarter into the bottom row", 5, 12, 64},
+		{"outside", 11, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.GetPixel(tt.x, tt.y).A; got != tt.expected {
+				t.Errorf("Expected alpha %d at (%d, %d), but got %d", tt.expected, tt.x, tt.y, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFillCircleArea(t *testing.T) {
+	c := NewCanvas(60, 60)
+	c.SetColour(colour.NewColourWhite())
+	c.BeginPath()
+	c.Arc(30, 30, 20, 0, 2*math.Pi, false)
+	c.Fill(FillNonZero)
+
+	covered := 0.0
+	for y := range 60 {
+		for x := range 60 {
+			covered += float64(c.GetPixel(x, y).A) / 255
+		}
+	}
+
+	if expected := math.Pi * 400; math.Abs(covered-expected) > expected*0.01 {
+		t.Errorf("Expected about %v pixels of coverage, but got %v", expected, covered)
+	}
+}
@@ -1,17 +1,29 @@
 package canvas
 
-import "github.com/ewaldhorn/gogi/colour"
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
 
 // ------------------------------------------------------------------------------------------------
 // ColourPutPixel draws a single pixel at coordinates x,y using the specified
 // colour. Does nothing if coordinates fall outside the canvas dimensions.
 // Now supports alpha blending.
 func (m *GogiCanvas) ColourPutPixel(x, y int, p colour.Colour) {
+	if p.A != 0 {
+		m.markDirty(x, y, 1, 1)
+	}
+	m.colourPutPixel(x, y, p)
+}
+
+// ------------------------------------------------------------------------------------------------
+// colourPutPixel draws a pixel without dirty tracking, for use by the shape functions that
+// mark their whole bounding box as dirty up front.
+func (m *GogiCanvas) colourPutPixel(x, y int, p colour.Colour) {
 	const bytesPerPixel = 4
-	offset := (x * bytesPerPixel) + (y * bytesPerPixel * m.width)
 
 	// don't bother if we are outside our area
-	if offset < 0 || offset+bytesPerPixel >= m.bufferSize {
+	if x < 0 || x >= m.width || y < 0 || y >= m.height {
 		return
 	}
 
@@ -20,6 +32,8 @@ func (m *GogiCanvas) ColourPutPixel(x, y int, p colour.Colour) {
 		return
 	}
 
+	offset := (x * bytesPerPixel) + (y * bytesPerPixel * m.width)
+
 	if p.A == 255 {
 		// full alpha, use this colour only
 		m.pixelBuffer[offset] = p.R
@@ -37,6 +51,26 @@ func (m *GogiCanvas) ColourPutPixel(x, y int, p colour.Colour) {
 	m.pixelBuffer[offset+3] = blendedColour.A
 }
 
+// ------------------------------------------------------------------------------------------------
+// DrawPixels stretches src over the whole canvas, taking the nearest source pixel for every
+// canvas pixel. The canvas is marked dirty once, so copying a whole frame this way is much
+// cheaper than calling ColourPutPixel for every pixel.
+func (m *GogiCanvas) DrawPixels(src buffers.PixelSource) {
+	srcWidth, srcHeight := src.Width(), src.Height()
+	if srcWidth <= 0 || srcHeight <= 0 {
+		return
+	}
+
+	m.markDirty(0, 0, m.width, m.height)
+
+	for y := range m.height {
+		srcY := y * srcHeight / m.height
+		for x := range m.width {
+			m.colourPutPixel(x, y, src.GetPixel(x*srcWidth/m.width, srcY))
+		}
+	}
+}
+
 // ------------------------------------------------------------------------------------------------
 func blendColors(fg, bg colour.Colour) colour.Colour {
 	return colour.Colour{
@@ -3,6 +3,7 @@ package canvas
 import (
 	"testing"
 
+	"github.com/ewaldhorn/gogi/buffers"
 	"github.com/ewaldhorn/gogi/colour"
 )
 
@@ -82,3 +83,38 @@ func TestBlendComponent(t *testing.T) {
 		t.Errorf("Expected 64, got %d", val)
 	}
 }
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawPixels(t *testing.T) {
+	red, blue := colour.NewColour(255, 0, 0, 255), colour.NewColour(0, 0, 255, 255)
+	src := buffers.NewPixelBuffer(2, 1, make([]uint8, 2*buffers.RGBABytesPerPixel))
+	src.ColourPutPixel(0, 0, red)
+	src.ColourPutPixel(1, 0, blue)
+
+	canvas := NewCanvas(4, 2)
+	canvas.ClearDirtyRects()
+	canvas.DrawPixels(src)
+
+	tests := []struct {
+		name     string
+		x, y     int
+		expected colour.Colour
+	}{
+		{"top left", 0, 0, red},
+		{"stretched left", 1, 1, red},
+		{"stretched right", 2, 0, blue},
+		{"bottom right", 3, 1, blue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canvas.GetPixel(tt.x, tt.y); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if rects := canvas.DirtyRects(); len(rects) != 1 || rects[0] != canvas.Bounds() {
+		t.Errorf("Expected the whole canvas to be dirty, but got %v", rects)
+	}
+}
@@ -1,6 +1,45 @@
 package canvas
 
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/randomness"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
 // ------------------------------------------------------------------------------------------------
 type Point struct {
 	X, Y int
 }
+
+// ------------------------------------------------------------------------------------------------
+func (p Point) Vec2() vecmath.Vec2 {
+	return vecmath.Vec2{X: float64(p.X), Y: float64(p.Y)}
+}
+
+// ------------------------------------------------------------------------------------------------
+// PointFromVec2 rounds a vector to the nearest pixel.
+func PointFromVec2(v vecmath.Vec2) Point {
+	return Point{X: int(math.Round(v.X)), Y: int(math.Round(v.Y))}
+}
+
+// ------------------------------------------------------------------------------------------------
+// FromRandomPoints converts points from randomness, such as Poisson-disc samples, to canvas
+// points. A single point converts directly with Point(p).
+func FromRandomPoints(points []randomness.Point) []Point {
+	converted := make([]Point, len(points))
+	for i, p := range points {
+		converted[i] = Point(p)
+	}
+	return converted
+}
+
+// ------------------------------------------------------------------------------------------------
+// toVec2s converts points to vectors for the floating point drawing code.
+func toVec2s(points []Point) []vecmath.Vec2 {
+	positions := make([]vecmath.Vec2, len(points))
+	for i, p := range points {
+		positions[i] = p.Vec2()
+	}
+	return positions
+}
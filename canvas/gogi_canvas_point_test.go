@@ -0,0 +1,49 @@
+package canvas
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/randomness"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestFromRandomPoints(t *testing.T) {
+	source := randomness.NewSource(3)
+	points := []randomness.Point{source.PointInRect(0, 0, 10, 10), {X: -4, Y: 7}}
+
+	converted := FromRandomPoints(points)
+	if len(converted) != 2 {
+		t.Fatalf("Expected 2 points, but got %d", len(converted))
+	}
+
+	for i, p := range points {
+		if converted[i].X != p.X || converted[i].Y != p.Y {
+			t.Errorf("Expected %v, but got %v", p, converted[i])
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPointVec2(t *testing.T) {
+	tests := []struct {
+		name     string
+		v        vecmath.Vec2
+		expected Point
+	}{
+		{name: "whole", v: vecmath.Vec2{X: 3, Y: -4}, expected: Point{X: 3, Y: -4}},
+		{name: "rounded", v: vecmath.Vec2{X: 2.5, Y: -1.6}, expected: Point{X: 3, Y: -2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PointFromVec2(tt.v); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if (Point{X: 7, Y: -2}).Vec2() != (vecmath.Vec2{X: 7, Y: -2}) {
+		t.Error("Expected the point as a vector")
+	}
+}
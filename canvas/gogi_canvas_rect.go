@@ -0,0 +1,58 @@
+package canvas
+
+// ------------------------------------------------------------------------------------------------
+// Rect is an axis aligned rectangle in canvas pixel coordinates.
+type Rect struct {
+	X, Y, Width, Height int
+}
+
+// ------------------------------------------------------------------------------------------------
+func (r Rect) IsEmpty() bool {
+	return r.Width <= 0 || r.Height <= 0
+}
+
+// ------------------------------------------------------------------------------------------------
+// Intersect returns the area covered by both rectangles, which may be empty.
+func (r Rect) Intersect(other Rect) Rect {
+	x0 := max(r.X, other.X)
+	y0 := max(r.Y, other.Y)
+	x1 := min(r.X+r.Width, other.X+other.Width)
+	y1 := min(r.Y+r.Height, other.Y+other.Height)
+
+	if x1 <= x0 || y1 <= y0 {
+		return Rect{}
+	}
+
+	return Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Union returns the smallest rectangle containing both rectangles.
+func (r Rect) Union(other Rect) Rect {
+	if r.IsEmpty() {
+		return other
+	}
+	if other.IsEmpty() {
+		return r
+	}
+
+	x0 := min(r.X, other.X)
+	y0 := min(r.Y, other.Y)
+	x1 := max(r.X+r.Width, other.X+other.Width)
+	y1 := max(r.Y+r.Height, other.Y+other.Height)
+
+	return Rect{X: x0, Y: y0, Width: x1 - x0, Height: y1 - y0}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Touches reports whether the rectangles overlap or share an edge.
+func (r Rect) Touches(other Rect) bool {
+	return r.X <= other.X+other.Width && other.X <= r.X+r.Width &&
+		r.Y <= other.Y+other.Height && other.Y <= r.Y+r.Height
+}
+
+// ------------------------------------------------------------------------------------------------
+// Contains reports whether the point lies inside the rectangle.
+func (r Rect) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
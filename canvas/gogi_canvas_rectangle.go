@@ -4,6 +4,8 @@ import "github.com/ewaldhorn/gogi/colour"
 
 // ------------------------------------------------------------------------------------------------
 func (m *GogiCanvas) DrawRectangle(x, y, width, height int, drawColour colour.Colour) {
+	m.markDirty(x, y, width, height)
+
 	for py := y; py < y+height; py++ {
 		for px := x; px < x+width; px++ {
 			if px >= 0 && px < m.width && py >= 0 && py < m.height {
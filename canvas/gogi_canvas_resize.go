@@ -0,0 +1,31 @@
+package canvas
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Resize changes the canvas dimensions, applying the resize mode to every page. Memory is
+// reused when the pages are large enough. The whole canvas is marked dirty and the address
+// of the visual page is returned, since the host has to pick up the new buffer.
+func (m *GogiCanvas) Resize(width, height int, mode buffers.ResizeMode) uintptr {
+	width, height = max(1, width), max(1, height)
+
+	if width != m.width || height != m.height {
+		for i, page := range m.pages {
+			m.pages[i] = buffers.ResizePixels(page, m.width, m.height, width, height, mode)
+		}
+
+		m.width = width
+		m.height = height
+		m.bufferSize = width * height * colour.BYTES_PER_PIXEL
+		m.pixelBuffer = m.pages[m.activePage]
+		m.layersDirty = true
+		// the cached composite is laid out for the old width, even when the byte count matches
+		m.layerBaseCount = 0
+	}
+
+	m.MarkAllDirty()
+	return m.GetBufferPointer()
+}
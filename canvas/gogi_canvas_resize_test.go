@@ -0,0 +1,75 @@
+package canvas
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestResizeCanvas(t *testing.T) {
+	canvas := NewCanvasWithPages(10, 10, 2)
+	red := colour.NewColour(255, 0, 0, 255)
+
+	canvas.ColourPutPixel(2, 2, red)
+	canvas.ColourPutPixel(8, 8, red)
+	canvas.ClearDirtyRects()
+
+	pointer := canvas.Resize(5, 4, buffers.ResizePreserve)
+
+	if canvas.Width() != 5 || canvas.Height() != 4 || canvas.GetBufferLength() != 5*4*4 {
+		t.Fatalf("Unexpected size %dx%d", canvas.Width(), canvas.Height())
+	}
+
+	if pointer != canvas.GetBufferPointer() {
+		t.Error("Expected Resize to return the buffer pointer")
+	}
+
+	if pixel := canvas.GetPixel(2, 2); pixel != red {
+		t.Errorf("Expected the preserved pixel to stay red, got %v", pixel)
+	}
+
+	if rects := canvas.DirtyRects(); len(rects) != 1 || rects[0] != canvas.Bounds() {
+		t.Errorf("Expected the resized canvas to be fully dirty, got %v", rects)
+	}
+
+	// drawing at the new right edge must land in the right spot
+	canvas.ColourPutPixel(4, 3, red)
+	if pixel := canvas.GetPixel(4, 3); pixel != red {
+		t.Errorf("Expected pixel at the new edge to be red, got %v", pixel)
+	}
+
+	canvas.Present()
+	canvas.Resize(20, 20, buffers.ResizeClear)
+	if pixel := canvas.GetPixel(2, 2); !pixel.IsEmpty() {
+		t.Errorf("Expected a cleared canvas, got %v", pixel)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestResizeCanvasSameAreaDropsLayerCache(t *testing.T) {
+	canvas := NewCanvas(4, 8)
+	red := colour.NewColour(255, 0, 0, 255)
+	green := colour.NewColour(0, 255, 0, 255)
+
+	background := canvas.AddLayer(8, 8)
+	background.ColourPutPixel(0, 1, red)
+	sprite := canvas.AddLayer(8, 8)
+
+	canvas.FlattenLayers()
+	sprite.ColourPutPixel(7, 7, green)
+	canvas.FlattenLayers()
+
+	canvas.Resize(8, 4, buffers.ResizeClear)
+	sprite.ColourPutPixel(6, 3, green)
+	canvas.FlattenLayers()
+
+	if pixel := canvas.GetPixel(0, 1); pixel != red {
+		t.Errorf("Expected the background pixel at (0,1), got %v", pixel)
+	}
+
+	if pixel := canvas.GetPixel(4, 0); !pixel.IsEmpty() {
+		t.Errorf("Expected no stale background pixel at (4,0), got %v", pixel)
+	}
+}
@@ -0,0 +1,236 @@
+package canvas
+
+import (
+	"math"
+	"slices"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// LineJoin is the shape drawn where two lines of a stroked path meet.
+type LineJoin int
+
+const (
+	// JoinMiter extends the outer edges until they meet in a point, falling back to a bevel
+	// for corners so sharp the point would stick out further than the miter limit.
+	JoinMiter LineJoin = iota
+	JoinRound
+	// JoinBevel cuts the corner off straight.
+	JoinBevel
+)
+
+// ------------------------------------------------------------------------------------------------
+// LineCap is the shape drawn at the open ends of a stroked path and its dashes.
+type LineCap int
+
+const (
+	// CapButt ends the line flat, exactly at its end point.
+	CapButt LineCap = iota
+	CapRound
+	// CapSquare ends the line flat, half the thickness past its end point.
+	CapSquare
+)
+
+// ------------------------------------------------------------------------------------------------
+// DEFAULT_MITER_LIMIT is the longest a miter may be, as a multiple of the thickness.
+const DEFAULT_MITER_LIMIT = 10
+
+// ------------------------------------------------------------------------------------------------
+// strokeStyle describes how lines through floating point positions are drawn.
+type strokeStyle struct {
+	thickness  float64
+	antiAlias  bool
+	join       LineJoin
+	cap        LineCap
+	miterLimit float64
+	dashes     []float64
+	dashOffset float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// StrokeOption changes how curves and polylines are drawn.
+type StrokeOption func(*strokeStyle)
+
+// ------------------------------------------------------------------------------------------------
+// WithThickness sets the width of the line in pixels, one by default.
+func WithThickness(thickness float64) StrokeOption {
+	return func(s *strokeStyle) {
+		s.thickness = max(0, thickness)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithAntiAlias softens the edges of the line by blending pixels it only partly covers.
+func WithAntiAlias() StrokeOption {
+	return func(s *strokeStyle) {
+		s.antiAlias = true
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithJoin sets how corners are drawn, mitered by default for paths and round for polylines
+// and curves.
+func WithJoin(join LineJoin) StrokeOption {
+	return func(s *strokeStyle) {
+		s.join = join
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithCap sets how the ends of lines are drawn, flat by default for paths and round for
+// polylines and curves.
+func WithCap(lineCap LineCap) StrokeOption {
+	return func(s *strokeStyle) {
+		s.cap = lineCap
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithMiterLimit sets how far mitered corners may stick out, as a multiple of the thickness.
+func WithMiterLimit(limit float64) StrokeOption {
+	return func(s *strokeStyle) {
+		s.miterLimit = max(1, limit)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithDash draws the line in dashes, alternating between drawn and skipped lengths from the
+// pattern. An odd pattern is repeated to make it even, like the HTML canvas does. Offset
+// starts that far into the pattern.
+func WithDash(offset float64, pattern ...float64) StrokeOption {
+	return func(s *strokeStyle) {
+		total := 0.0
+		for _, length := range pattern {
+			if length < 0 {
+				return
+			}
+			total += length
+		}
+		if total == 0 {
+			s.dashes = nil
+			return
+		}
+
+		s.dashes = slices.Clone(pattern)
+		if len(pattern)%2 == 1 {
+			s.dashes = append(s.dashes, pattern...)
+		}
+		s.dashOffset = offset
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func newStrokeStyle(options []StrokeOption) strokeStyle {
+	style := strokeStyle{thickness: 1, miterLimit: DEFAULT_MITER_LIMIT}
+	for _, option := range options {
+		option(&style)
+	}
+	return style
+}
+
+// ------------------------------------------------------------------------------------------------
+// DrawPolyline draws connected lines through the points, using the active colour. Corners
+// and ends are round unless WithJoin or WithCap say otherwise.
+func (m *GogiCanvas) DrawPolyline(points []Point, options ...StrokeOption) {
+	m.strokePolyline(toVec2s(points), newPolylineStyle(options))
+}
+
+// ------------------------------------------------------------------------------------------------
+// newPolylineStyle is the style for DrawPolyline and the curves, which default to round
+// joins and caps so curves flattened into many short lines stay smooth.
+func newPolylineStyle(options []StrokeOption) strokeStyle {
+	return newStrokeStyle(append([]StrokeOption{WithJoin(JoinRound), WithCap(CapRound)}, options...))
+}
+
+// ------------------------------------------------------------------------------------------------
+// strokePolyline draws a line of the given style through the positions, dashed if the style
+// asks for it. The outline is filled as one shape, so semi-transparent colours don't darken
+// where segments meet.
+func (m *GogiCanvas) strokePolyline(points []vecmath.Vec2, style strokeStyle) {
+	points = withoutRepeats(points)
+	if len(points) == 0 || style.thickness == 0 {
+		return
+	}
+
+	var polygons [][]vecmath.Vec2
+	for _, piece := range style.dash(points, false) {
+		polygons = style.outline(polygons, withoutRepeats(piece), false)
+	}
+
+	m.fillPolygons(polygons, FillNonZero, style.antiAlias)
+}
+
+// ------------------------------------------------------------------------------------------------
+// dash splits the polyline into the dashes of the style's pattern, or returns it whole when
+// there is no pattern. A closed polyline is dashed all the way round.
+func (s strokeStyle) dash(points []vecmath.Vec2, closed bool) [][]vecmath.Vec2 {
+	if len(s.dashes) == 0 {
+		return [][]vecmath.Vec2{points}
+	}
+
+	if closed {
+		points = append(slices.Clone(points), points[0])
+	}
+
+	total := 0.0
+	for _, length := range s.dashes {
+		total += length
+	}
+
+	// skip ahead by the offset
+	phase := math.Mod(math.Mod(s.dashOffset, total)+total, total)
+	index, remaining := 0, s.dashes[0]
+	for phase > 0 {
+		if phase < remaining {
+			remaining -= phase
+			break
+		}
+		phase -= remaining
+		index = (index + 1) % len(s.dashes)
+		remaining = s.dashes[index]
+	}
+
+	var pieces [][]vecmath.Vec2
+	var piece []vecmath.Vec2
+	drawing := index%2 == 0
+	if drawing {
+		piece = []vecmath.Vec2{points[0]}
+	}
+
+	for i := 1; i < len(points); i++ {
+		a, b := points[i-1], points[i]
+		length := a.Distance(b)
+		if length == 0 {
+			continue
+		}
+
+		// switch between drawing and skipping wherever the pattern says within this segment
+		travelled := 0.0
+		for length-travelled >= remaining {
+			travelled += remaining
+			p := a.Lerp(b, travelled/length)
+
+			if drawing {
+				pieces = append(pieces, append(piece, p))
+			} else {
+				piece = []vecmath.Vec2{p}
+			}
+
+			drawing = !drawing
+			index = (index + 1) % len(s.dashes)
+			remaining = s.dashes[index]
+		}
+
+		remaining -= length - travelled
+		if drawing {
+			piece = append(piece, b)
+		}
+	}
+
+	if drawing && len(piece) > 1 {
+		pieces = append(pieces, piece)
+	}
+
+	return pieces
+}
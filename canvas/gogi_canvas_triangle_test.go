@@ -51,3 +51,46 @@ func TestDrawTriangle(t *testing.T) {
 		t.Errorf("Expected pixel at (0, 0) to be black/transparent, but got %v", pixelOutside)
 	}
 }
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawTrianglePointedTo(t *testing.T) {
+	tests := []struct {
+		name        string
+		destination Point
+		corners     []Point
+		absent      []Point
+	}{
+		{name: "right", destination: Point{X: 60, Y: 30}, corners: []Point{{X: 10, Y: 35}, {X: 10, Y: 25}}},
+		// cos(Pi/2) is not quite zero, so one corner truncates to 4.999 from the tip
+		{name: "down", destination: Point{X: 30, Y: 50}, corners: []Point{{X: 25, Y: 10}, {X: 34, Y: 10}}},
+		// the corners are 17.68 and 10.61 from the tip, truncated rather than rounded
+		{
+			name:        "diagonal",
+			destination: Point{X: 60, Y: 60},
+			corners:     []Point{{X: 13, Y: 20}, {X: 20, Y: 13}},
+			absent:      []Point{{X: 12, Y: 19}, {X: 19, Y: 12}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canvas := NewCanvas(40, 40)
+			white := colour.NewColourWhite()
+			canvas.SetColour(white)
+
+			canvas.DrawTrianglePointedTo(Point{X: 30, Y: 30}, tt.destination)
+
+			for _, p := range append(tt.corners, Point{X: 30, Y: 30}) {
+				if canvas.GetPixel(p.X, p.Y) != white {
+					t.Errorf("Expected a corner at (%d, %d)", p.X, p.Y)
+				}
+			}
+
+			for _, p := range tt.absent {
+				if canvas.GetPixel(p.X, p.Y) == white {
+					t.Errorf("Expected nothing at (%d, %d)", p.X, p.Y)
+				}
+			}
+		})
+	}
+}
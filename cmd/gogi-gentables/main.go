@@ -0,0 +1,218 @@
+// gogi-gentables writes Go source files holding precomputed lookup tables and palettes.
+// The tables are array literals, which the compiler lays out as data, so programs using
+// them skip working the values out at startup. Use it from a go:generate line:
+//
+//	//go:generate go run github.com/ewaldhorn/gogi/cmd/gogi-gentables -package main -out tables_gen.go -tables sin,fire -size 4096
+//
+// Sine and cosine tables cover one full turn, so entry i holds the value for
+// i * 2 * Pi / size. The square root table holds the roots of the whole numbers from 0 to
+// size - 1. The size must be a power of two. Palettes always have 256 colours.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// VALUES_PER_LINE keeps the generated files readable.
+const VALUES_PER_LINE = 4
+
+// ------------------------------------------------------------------------------------------------
+// table is something the generator can write, with the name it gets in the generated file.
+type table struct {
+	name    string
+	comment string
+	values  func(size int) []float64
+	palette func() []colour.Colour
+}
+
+// ------------------------------------------------------------------------------------------------
+var tables = map[string]table{
+	"sin": {
+		name:    "SineTable",
+		comment: "holds the sine of i * 2 * Pi / size at index i.",
+		values:  func(size int) []float64 { return turn(size, math.Sin) },
+	},
+	"cos": {
+		name:    "CosineTable",
+		comment: "holds the cosine of i * 2 * Pi / size at index i.",
+		values:  func(size int) []float64 { return turn(size, math.Cos) },
+	},
+	"sqrt": {
+		name:    "SqrtTable",
+		comment: "holds the square root of i at index i.",
+		values: func(size int) []float64 {
+			values := make([]float64, size)
+			for i := range values {
+				values[i] = math.Sqrt(float64(i))
+			}
+			return values
+		},
+	},
+	"fire": {
+		name:    "FirePalette",
+		comment: "is colour.GetFirePalette.",
+		palette: colour.GetFirePalette,
+	},
+	"allred": {
+		name:    "AllRedPalette",
+		comment: "is colour.GetAllRedPalette.",
+		palette: colour.GetAllRedPalette,
+	},
+	"plasma": {
+		name:    "PlasmaPalette",
+		comment: "is colour.GetPlasmaPalette.",
+		palette: colour.GetPlasmaPalette,
+	},
+}
+
+// ------------------------------------------------------------------------------------------------
+// options describes the file to generate.
+type options struct {
+	packageName string
+	prefix      string
+	size        int
+	tables      []string
+}
+
+// ------------------------------------------------------------------------------------------------
+func main() {
+	packageName := flag.String("package", "main", "package name of the generated file")
+	out := flag.String("out", "", "file to write, standard output when empty")
+	names := flag.String("tables", "sin", "comma separated tables to generate: sin, cos, sqrt, fire, allred, plasma")
+	size := flag.Int("size", 4096, "number of entries in the sin, cos and sqrt tables")
+	prefix := flag.String("prefix", "", "prefix for the variable names, a lower case prefix keeps them unexported")
+	flag.Parse()
+
+	var buffer bytes.Buffer
+	err := generate(&buffer, options{
+		packageName: *packageName,
+		prefix:      *prefix,
+		size:        *size,
+		tables:      strings.Split(*names, ","),
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		_, err = os.Stdout.Write(buffer.Bytes())
+	} else {
+		err = os.WriteFile(*out, buffer.Bytes(), 0o644)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// generate writes a formatted Go source file with the requested tables.
+func generate(w io.Writer, opts options) error {
+	// the lookups package wraps indexes with a bitmask, which needs a power of two
+	if opts.size < 1 || opts.size&(opts.size-1) != 0 {
+		return fmt.Errorf("table size must be a power of two, got %d", opts.size)
+	}
+
+	var source bytes.Buffer
+	out := bufio.NewWriter(&source)
+
+	usesColour := false
+	for _, name := range opts.tables {
+		t, ok := tables[strings.TrimSpace(name)]
+		if !ok {
+			return fmt.Errorf("unknown table %q", name)
+		}
+		usesColour = usesColour || t.palette != nil
+	}
+
+	fmt.Fprintln(out, "// Code generated by gogi-gentables; DO NOT EDIT.")
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "package %s\n\n", opts.packageName)
+	if usesColour {
+		fmt.Fprintf(out, "import %q\n\n", "github.com/ewaldhorn/gogi/colour")
+	}
+
+	for _, name := range opts.tables {
+		t := tables[strings.TrimSpace(name)]
+		variable := variableName(opts.prefix, t.name)
+
+		fmt.Fprintln(out, "// ------------------------------------------------------------------------------------------------")
+		fmt.Fprintf(out, "// %s %s\n", variable, t.comment)
+
+		if t.palette != nil {
+			writePalette(out, variable, t.palette())
+		} else {
+			writeValues(out, variable, t.values(opts.size))
+		}
+	}
+
+	if err := out.Flush(); err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(formatted)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// variableName puts the prefix in front of the table name, so "default" gives
+// "defaultSineTable".
+func variableName(prefix, name string) string {
+	return prefix + name
+}
+
+// ------------------------------------------------------------------------------------------------
+// turn samples f over one full turn.
+func turn(size int, f func(float64) float64) []float64 {
+	values := make([]float64, size)
+	step := 2 * math.Pi / float64(size)
+	for i := range values {
+		values[i] = f(float64(i) * step)
+	}
+	return values
+}
+
+// ------------------------------------------------------------------------------------------------
+func writeValues(out *bufio.Writer, variable string, values []float64) {
+	fmt.Fprintf(out, "var %s = [%d]float64{\n", variable, len(values))
+	for i, value := range values {
+		// the shortest form that reads back as exactly the same float64
+		out.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+		out.WriteString(",")
+		if (i+1)%VALUES_PER_LINE == 0 || i == len(values)-1 {
+			out.WriteString("\n")
+		} else {
+			out.WriteString(" ")
+		}
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out)
+}
+
+// ------------------------------------------------------------------------------------------------
+func writePalette(out *bufio.Writer, variable string, palette []colour.Colour) {
+	fmt.Fprintf(out, "var %s = [%d]colour.Colour{\n", variable, len(palette))
+	for _, c := range palette {
+		fmt.Fprintf(out, "{R: %d, G: %d, B: %d, A: %d},\n", c.R, c.G, c.B, c.A)
+	}
+	fmt.Fprintln(out, "}")
+	fmt.Fprintln(out)
+}
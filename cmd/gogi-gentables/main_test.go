@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     options
+		contains []string
+		missing  []string
+	}{
+		{
+			name: "sine table",
+			opts: options{packageName: "lookups", prefix: "default", size: 4, tables: []string{"sin"}},
+			contains: []string{
+				"// Code generated by gogi-gentables; DO NOT EDIT.",
+				"package lookups",
+				"var defaultSineTable = [4]float64{",
+				"0, 1, 1.2246467991473515e-16, -1,",
+			},
+			missing: []string{"import"},
+		},
+		{
+			name:     "square roots",
+			opts:     options{packageName: "main", size: 8, tables: []string{"sqrt"}},
+			contains: []string{"var SqrtTable = [8]float64{", "0, 1, 1.4142135623730951, 1.7320508075688772,\n\t2,"},
+		},
+		{
+			name: "palette",
+			opts: options{packageName: "main", size: 4, tables: []string{"cos", " fire"}},
+			contains: []string{
+				`import "github.com/ewaldhorn/gogi/colour"`,
+				"var CosineTable = [4]float64{",
+				"var FirePalette = [256]colour.Colour{",
+				"{R: 255, G: 255, B: 255, A: 255},\n}",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := generate(&out, tt.opts); err != nil {
+				t.Fatal(err)
+			}
+
+			source := out.String()
+			if _, err := parser.ParseFile(token.NewFileSet(), "tables_gen.go", source, 0); err != nil {
+				t.Fatalf("Expected valid Go source, but got %v", err)
+			}
+
+			for _, expected := range tt.contains {
+				if !strings.Contains(source, expected) {
+					t.Errorf("Expected the output to contain %q", expected)
+				}
+			}
+			for _, unexpected := range tt.missing {
+				if strings.Contains(source, unexpected) {
+					t.Errorf("Expected the output not to contain %q", unexpected)
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		opts options
+	}{
+		{name: "unknown table", opts: options{packageName: "main", size: 4, tables: []string{"tan"}}},
+		{name: "empty table", opts: options{packageName: "main", size: 0, tables: []string{"sin"}}},
+		{name: "not a power of two", opts: options{packageName: "main", size: 6, tables: []string{"sin"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := generate(&bytes.Buffer{}, tt.opts); err == nil {
+				t.Error("Expected an error, but got none")
+			}
+		})
+	}
+}
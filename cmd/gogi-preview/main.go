@@ -0,0 +1,186 @@
+// gogi-preview runs a small animated scene natively and shows it through one of the display
+// backends, so drawing code can be checked without a browser.
+//
+//	go run ./cmd/gogi-preview -backend terminal -columns 100 -rows 40
+//	go run ./cmd/gogi-preview -backend sixel -effect tunnel
+//	go run ./cmd/gogi-preview -backend png -out /tmp/preview.png
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"os"
+	"os/signal"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/display"
+	"github.com/ewaldhorn/gogi/effects"
+	"github.com/ewaldhorn/gogi/parallel"
+	"github.com/ewaldhorn/gogi/terminal"
+	"github.com/ewaldhorn/gogi/timing"
+)
+
+// ------------------------------------------------------------------------------------------------
+type scene struct {
+	canvas  *canvas.GogiCanvas
+	display display.Display
+	t       float64
+	err     error
+
+	// effect replaces the moving circles when set
+	effect   effects.Effect
+	frame    *buffers.PixelBuffer
+	renderer *parallel.Renderer
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *scene) FixedUpdate(step float64) {
+	s.t += step
+	if s.effect != nil {
+		s.effect.Update(step)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *scene) Render(alpha float64) {
+	c := s.canvas
+	c.ClearBuffer()
+
+	if s.effect != nil {
+		s.renderEffect()
+		return
+	}
+
+	w, h := float64(c.Width()), float64(c.Height())
+	for i := range 5 {
+		phase := s.t + float64(i)*1.3
+		x := int(w/2 + math.Cos(phase)*w/3)
+		y := int(h/2 + math.Sin(phase*1.7)*h/3)
+		r, g, b := colour.HSLToRGB(math.Mod(phase/6, 1), 1, 0.5)
+		c.DrawFilledCircle(x, y, int(h/8), colour.NewColour(r, g, b, 255))
+	}
+
+	c.SetColour(colour.NewColourWhite())
+	c.DrawLine(0, c.Height()-1, c.Width()-1, c.Height()-1)
+
+	s.show()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *scene) renderEffect() {
+	s.renderer.RenderEffect(s.effect, s.frame)
+	s.canvas.DrawPixels(s.frame)
+	s.show()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *scene) show() {
+	s.canvas.Present()
+	if err := s.display.Show(s.canvas); err != nil && s.err == nil {
+		s.err = err
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// newEffect creates one of the effects package effects by name, or nil for the circles.
+func newEffect(name string, width, height int) (effects.Effect, bool) {
+	switch name {
+	case "circles":
+		return nil, true
+	case "plasma":
+		return effects.NewPlasma(), true
+	case "fire":
+		return effects.NewFire(width, height), true
+	case "doomfire":
+		return effects.NewDoomFire(width, height), true
+	case "starfield":
+		return effects.NewStarfield(200), true
+	case "tunnel":
+		return effects.NewTunnel(), true
+	case "rotozoomer":
+		return effects.NewRotozoomer(), true
+	case "metaballs":
+		return effects.NewMetaballs(5), true
+	case "water":
+		return effects.NewWater(width, height), true
+	case "copper":
+		return effects.NewCopperBars(6), true
+	}
+	return nil, false
+}
+
+// ------------------------------------------------------------------------------------------------
+func main() {
+	backend := flag.String("backend", "terminal", "display backend: terminal, sixel, kitty, png or framebuffer")
+	out := flag.String("out", "preview.png", "output path for the png backend, may contain %d")
+	device := flag.String("device", "/dev/fb0", "device for the framebuffer backend")
+	width := flag.Int("width", 160, "canvas width in pixels")
+	height := flag.Int("height", 90, "canvas height in pixels")
+	columns := flag.Int("columns", 0, "terminal columns, 0 for one per pixel")
+	rows := flag.Int("rows", 0, "terminal rows, 0 for one per two pixels")
+	effectName := flag.String("effect", "circles", "scene to show: circles, plasma, fire, doomfire, starfield, tunnel, rotozoomer, metaballs, water or copper")
+	frames := flag.Int("frames", 0, "render this many frames as fast as possible, 0 to run until interrupted")
+	flag.Parse()
+
+	effect, ok := newEffect(*effectName, *width, *height)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown effect %q\n", *effectName)
+		os.Exit(2)
+	}
+
+	var d display.Display
+	switch *backend {
+	case "terminal":
+		terminalDisplay := display.NewTerminalDisplay(os.Stdout, *columns, *rows)
+		terminalDisplay.SetColourMode(terminal.DetectColourMode())
+		d = terminalDisplay
+	case "sixel":
+		d = display.NewTerminalImageDisplay(os.Stdout, display.ProtocolSixel)
+	case "kitty":
+		d = display.NewTerminalImageDisplay(os.Stdout, display.ProtocolKitty)
+	case "png":
+		d = display.NewPNGDisplay(*out)
+	case "framebuffer":
+		framebuffer, err := display.OpenFramebuffer(*device)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		d = framebuffer
+	default:
+		fmt.Fprintf(os.Stderr, "unknown backend %q\n", *backend)
+		os.Exit(2)
+	}
+	defer d.Close()
+
+	s := &scene{canvas: canvas.NewCanvasWithPages(*width, *height, 2), display: d, effect: effect}
+	if effect != nil {
+		s.renderer = parallel.NewRenderer(0)
+		defer s.renderer.Close()
+		s.frame = buffers.NewPixelBuffer(*width, *height, make([]uint8, *width**height*buffers.RGBABytesPerPixel))
+	}
+	loop := timing.NewLoop(s, timing.DEFAULT_STEP)
+
+	if *frames > 0 {
+		timing.RunHeadless(loop, *frames, timing.DEFAULT_STEP)
+	} else {
+		stop := make(chan struct{})
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		go func() {
+			<-interrupt
+			close(stop)
+		}()
+
+		timing.RunRealtime(loop, 30, stop)
+	}
+
+	if s.err != nil {
+		fmt.Fprintln(os.Stderr, s.err)
+		d.Close()
+		os.Exit(1)
+	}
+}
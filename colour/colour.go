@@ -2,6 +2,8 @@ package colour
 
 import (
 	"math/rand/v2"
+
+	"github.com/ewaldhorn/gogi/randomness"
 )
 
 // ------------------------------------------------------------------------------------------------
@@ -69,6 +71,17 @@ func NewRandomColour() Colour {
 	}
 }
 
+// ------------------------------------------------------------------------------------------------
+// NewRandomColourFrom is NewRandomColour drawing from the given source.
+func NewRandomColourFrom(source *randomness.Source) Colour {
+	return Colour{
+		R: uint8(source.Float32() * float32(MAX_COLOUR_VALUE)),
+		G: uint8(source.Float32() * float32(MAX_COLOUR_VALUE)),
+		B: uint8(source.Float32() * float32(MAX_COLOUR_VALUE)),
+		A: MAX_COLOUR_VALUE,
+	}
+}
+
 // ------------------------------------------------------------------------------------------------
 // Built using information from https://en.wikipedia.org/wiki/Grayscale
 // and https://stackoverflow.com/questions/42516203/converting-rgba-image-to-grayscale-golang
@@ -1,6 +1,11 @@
 package colour
 
-import "testing"
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/randomness"
+)
 
 // ------------------------------------------------------------------------------------------------
 func Test_createNewColour(t *testing.T) {
@@ -42,3 +47,43 @@ func Test_createEmptyColour(t *testing.T) {
 		t.Error("Colour value for EMPTY is wrong.")
 	}
 }
+
+// ------------------------------------------------------------------------------------------------
+func Test_createRandomColourFromSource(t *testing.T) {
+	first := NewRandomColourFrom(randomness.NewSource(11))
+	second := NewRandomColourFrom(randomness.NewSource(11))
+
+	if first != second {
+		t.Errorf("Expected the same seed to give the same colour, but got %v and %v", first, second)
+	}
+
+	if first.A != MAX_COLOUR_VALUE {
+		t.Error("Random colours should be opaque.")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func Test_plasmaPaletteMatchesDemoTable(t *testing.T) {
+	// the demo's sine table, truncated lookups into it gave the original colours
+	table := make([]float64, plasmaSineSteps)
+	for i := range table {
+		table[i] = math.Sin(float64(i) / plasmaSineSteps * 2 * math.Pi)
+	}
+	tableSin := func(angle float64) float64 {
+		return table[int(angle*(plasmaSineSteps/(2*math.Pi)))&(plasmaSineSteps-1)]
+	}
+
+	palette := GetPlasmaPalette()
+	for i, got := range palette {
+		input := float64(i)
+		expected := NewColour(
+			uint8(tableSin(input*0.02+0.0)*127.0+128.0),
+			uint8(tableSin(input*0.02+2.0)*64.0+190.0),
+			uint8(tableSin(input*0.02+4.0)*127.0+128.0),
+			255)
+
+		if got != expected {
+			t.Fatalf("Expected entry %d to be %v, but got %v", i, expected, got)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package colour
 
-import "github.com/ewaldhorn/gogi/utils"
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/utils"
+)
 
 // ------------------------------------------------------------------------------------------------
 func GetFirePalette() []Colour {
@@ -41,3 +45,33 @@ func GetAllRedPalette() []Colour {
 
 	return allRed
 }
+
+// ------------------------------------------------------------------------------------------------
+// plasmaSineSteps is the size of the sine table the demo built its plasma palette from.
+const plasmaSineSteps = 4096 * 4
+
+// ------------------------------------------------------------------------------------------------
+// plasmaSin gives exactly what the demo's sine table lookup gave, the angle is truncated to
+// a table entry, so the moved palette keeps the demo's colours.
+func plasmaSin(angle float64) float64 {
+	index := int(angle*(plasmaSineSteps/(2*math.Pi))) & (plasmaSineSteps - 1)
+	return math.Sin(float64(index) / plasmaSineSteps * 2 * math.Pi)
+}
+
+// ------------------------------------------------------------------------------------------------
+// GetPlasmaPalette returns the purple and green palette used by the plasma in the demo.
+func GetPlasmaPalette() []Colour {
+	plasma := make([]Colour, 256)
+
+	for i := range 256 {
+		colorInput := float64(i)
+
+		r := utils.ClampIntTo(int(plasmaSin(colorInput*0.02+0.0)*127.0+128.0), 0, 255)
+		g := utils.ClampIntTo(int(plasmaSin(colorInput*0.02+2.0)*64.0+190.0), 0, 255)
+		b := utils.ClampIntTo(int(plasmaSin(colorInput*0.02+4.0)*127.0+128.0), 0, 255)
+
+		plasma[i] = NewColour(uint8(r), uint8(g), uint8(b), 255)
+	}
+
+	return plasma
+}
@@ -0,0 +1,75 @@
+package curve
+
+import (
+	"sort"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// ArcLength measures a curve once so positions can be looked up by distance travelled
+// instead of by t. Equal steps in t bunch up where a curve's control points are close
+// together, equal steps in distance move at a steady speed.
+type ArcLength struct {
+	curve   Curve
+	ts      []float64
+	lengths []float64
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewArcLength(c Curve) *ArcLength {
+	a := &ArcLength{curve: c}
+
+	var previous vecmath.Vec2
+	flatten(c, LENGTH_TOLERANCE, true, func(t float64, p vecmath.Vec2) {
+		length := 0.0
+		if len(a.lengths) > 0 {
+			length = a.lengths[len(a.lengths)-1] + p.Distance(previous)
+		}
+		a.ts = append(a.ts, t)
+		a.lengths = append(a.lengths, length)
+		previous = p
+	})
+
+	return a
+}
+
+// ------------------------------------------------------------------------------------------------
+func (a *ArcLength) Length() float64 {
+	return a.lengths[len(a.lengths)-1]
+}
+
+// ------------------------------------------------------------------------------------------------
+// T returns the curve parameter distance along the curve, clamped to its ends. A NaN
+// distance is treated as the start.
+func (a *ArcLength) T(distance float64) float64 {
+	if !(distance > 0) {
+		return 0
+	}
+	if distance >= a.Length() {
+		return 1
+	}
+
+	// the first measured point at or past the distance
+	i := sort.SearchFloat64s(a.lengths, distance)
+	span := a.lengths[i] - a.lengths[i-1]
+	if span == 0 {
+		return a.ts[i]
+	}
+
+	fraction := (distance - a.lengths[i-1]) / span
+	return a.ts[i-1] + (a.ts[i]-a.ts[i-1])*fraction
+}
+
+// ------------------------------------------------------------------------------------------------
+// PointAt returns the position distance along the curve.
+func (a *ArcLength) PointAt(distance float64) vecmath.Vec2 {
+	return a.curve.Point(a.T(distance))
+}
+
+// ------------------------------------------------------------------------------------------------
+// DirectionAt returns the unit vector the curve heads in at distance along it, for turning
+// sprites to face the way they move.
+func (a *ArcLength) DirectionAt(distance float64) vecmath.Vec2 {
+	return a.curve.Tangent(a.T(distance)).Normalise()
+}
@@ -0,0 +1,72 @@
+package curve
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestArcLengthStraightLine(t *testing.T) {
+	// control points bunched at the start make t run slowly there, but distances don't care
+	c := Cubic{P1: vecmath.Vec2{X: 1}, P2: vecmath.Vec2{X: 2}, P3: vecmath.Vec2{X: 100}}
+	a := NewArcLength(c)
+
+	if math.Abs(a.Length()-100) > 1e-6 {
+		t.Fatalf("Expected length 100, but got %v", a.Length())
+	}
+
+	tests := []struct {
+		name     string
+		distance float64
+		expected vecmath.Vec2
+	}{
+		{"before the start", -5, vecmath.Vec2{}},
+		{"not a number", math.NaN(), vecmath.Vec2{}},
+		{"a quarter", 25, vecmath.Vec2{X: 25}},
+		{"half way", 50, vecmath.Vec2{X: 50}},
+		{"past the end", 150, vecmath.Vec2{X: 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := a.PointAt(tt.distance); !near(got, tt.expected, 0.05) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestArcLengthEvenSpacing(t *testing.T) {
+	s := NewCatmullRom([]vecmath.Vec2{{}, {X: 5, Y: 40}, {X: 80, Y: 50}, {X: 90}})
+	a := NewArcLength(s)
+
+	const steps = 20
+	step := a.Length() / steps
+	previous := a.PointAt(0)
+
+	for i := 1; i <= steps; i++ {
+		current := a.PointAt(float64(i) * step)
+
+		// steps are measured along the curve, so the straight gap is a little shorter
+		if gap := current.Distance(previous); gap > step*1.001 || gap < step*0.95 {
+			t.Errorf("Expected step %d to move about %v, but it moved %v", i, step, gap)
+		}
+		previous = current
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestArcLengthDirection(t *testing.T) {
+	c := Cubic{P1: vecmath.Vec2{X: 10}, P2: vecmath.Vec2{X: 20, Y: 10}, P3: vecmath.Vec2{X: 20, Y: 20}}
+	a := NewArcLength(c)
+
+	if got := a.DirectionAt(0); !near(got, vecmath.Vec2{X: 1}, tolerance) {
+		t.Errorf("Expected to head right at the start, but got %v", got)
+	}
+	if got := a.DirectionAt(a.Length()); !near(got, vecmath.Vec2{Y: 1}, tolerance) {
+		t.Errorf("Expected to head down at the end, but got %v", got)
+	}
+}
@@ -0,0 +1,65 @@
+package curve
+
+import "github.com/ewaldhorn/gogi/vecmath"
+
+// ------------------------------------------------------------------------------------------------
+// Quadratic is a Bezier curve from P0 to P2, pulled towards the control point P1.
+type Quadratic struct {
+	P0, P1, P2 vecmath.Vec2
+}
+
+// ------------------------------------------------------------------------------------------------
+func (q Quadratic) Point(t float64) vecmath.Vec2 {
+	u := 1 - t
+	return q.P0.Scale(u * u).Add(q.P1.Scale(2 * u * t)).Add(q.P2.Scale(t * t))
+}
+
+// ------------------------------------------------------------------------------------------------
+func (q Quadratic) Tangent(t float64) vecmath.Vec2 {
+	return q.P1.Sub(q.P0).Scale(2 * (1 - t)).Add(q.P2.Sub(q.P1).Scale(2 * t))
+}
+
+// ------------------------------------------------------------------------------------------------
+// Cubic is the same curve as q.
+func (q Quadratic) Cubic() Cubic {
+	return Cubic{
+		P0: q.P0,
+		P1: q.P0.Lerp(q.P1, 2.0/3),
+		P2: q.P2.Lerp(q.P1, 2.0/3),
+		P3: q.P2,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Cubic is a Bezier curve from P0 to P3. It leaves P0 heading for P1 and arrives at P3
+// coming from P2.
+type Cubic struct {
+	P0, P1, P2, P3 vecmath.Vec2
+}
+
+// ------------------------------------------------------------------------------------------------
+func (c Cubic) Point(t float64) vecmath.Vec2 {
+	u := 1 - t
+	return c.P0.Scale(u * u * u).
+		Add(c.P1.Scale(3 * u * u * t)).
+		Add(c.P2.Scale(3 * u * t * t)).
+		Add(c.P3.Scale(t * t * t))
+}
+
+// ------------------------------------------------------------------------------------------------
+func (c Cubic) Tangent(t float64) vecmath.Vec2 {
+	u := 1 - t
+	return c.P1.Sub(c.P0).Scale(3 * u * u).
+		Add(c.P2.Sub(c.P1).Scale(6 * u * t)).
+		Add(c.P3.Sub(c.P2).Scale(3 * t * t))
+}
+
+// ------------------------------------------------------------------------------------------------
+// Split cuts the curve in two at t, using de Casteljau's algorithm.
+func (c Cubic) Split(t float64) (Cubic, Cubic) {
+	p01, p12, p23 := c.P0.Lerp(c.P1, t), c.P1.Lerp(c.P2, t), c.P2.Lerp(c.P3, t)
+	p012, p123 := p01.Lerp(p12, t), p12.Lerp(p23, t)
+	middle := p012.Lerp(p123, t)
+
+	return Cubic{P0: c.P0, P1: p01, P2: p012, P3: middle}, Cubic{P0: middle, P1: p123, P2: p23, P3: c.P3}
+}
@@ -0,0 +1,80 @@
+package curve
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestQuadraticPoint(t *testing.T) {
+	q := Quadratic{P0: vecmath.Vec2{}, P1: vecmath.Vec2{X: 10, Y: 20}, P2: vecmath.Vec2{X: 20}}
+
+	tests := []struct {
+		name     string
+		t        float64
+		expected vecmath.Vec2
+	}{
+		{"start", 0, vecmath.Vec2{}},
+		{"middle", 0.5, vecmath.Vec2{X: 10, Y: 10}},
+		{"end", 1, vecmath.Vec2{X: 20}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := q.Point(tt.t); !near(got, tt.expected, tolerance) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestQuadraticCubic(t *testing.T) {
+	q := Quadratic{P0: vecmath.Vec2{X: 1, Y: 2}, P1: vecmath.Vec2{X: 30, Y: -20}, P2: vecmath.Vec2{X: 50, Y: 40}}
+	c := q.Cubic()
+
+	for i := range 11 {
+		at := float64(i) / 10
+		if !near(q.Point(at), c.Point(at), tolerance) || !near(q.Tangent(at), c.Tangent(at), 1e-6) {
+			t.Errorf("Expected the cubic to match the quadratic at %v, but got %v and %v", at, c.Point(at), q.Point(at))
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestCubicTangent(t *testing.T) {
+	c := Cubic{P1: vecmath.Vec2{X: 10}, P2: vecmath.Vec2{X: 20, Y: 10}, P3: vecmath.Vec2{X: 20, Y: 20}}
+
+	// the curve leaves towards P1 and arrives from P2, three times as fast as the control
+	// polygon
+	if got := c.Tangent(0); !near(got, vecmath.Vec2{X: 30}, tolerance) {
+		t.Errorf("Expected the start tangent to be (30, 0), but got %v", got)
+	}
+	if got := c.Tangent(1); !near(got, vecmath.Vec2{Y: 30}, tolerance) {
+		t.Errorf("Expected the end tangent to be (0, 30), but got %v", got)
+	}
+
+	// compare with a finite difference in the middle
+	const h = 1e-6
+	expected := c.Point(0.4 + h).Sub(c.Point(0.4 - h)).Scale(1 / (2 * h))
+	if got := c.Tangent(0.4); !near(got, expected, 1e-4) {
+		t.Errorf("Expected tangent %v, but got %v", expected, got)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestCubicSplit(t *testing.T) {
+	c := Cubic{P1: vecmath.Vec2{X: 10, Y: 30}, P2: vecmath.Vec2{X: 40, Y: -10}, P3: vecmath.Vec2{X: 50, Y: 20}}
+	first, second := c.Split(0.3)
+
+	for i := range 11 {
+		at := float64(i) / 10
+		if got := first.Point(at); !near(got, c.Point(at*0.3), tolerance) {
+			t.Errorf("Expected the first half at %v to be %v, but got %v", at, c.Point(at*0.3), got)
+		}
+		if got := second.Point(at); !near(got, c.Point(0.3+at*0.7), tolerance) {
+			t.Errorf("Expected the second half at %v to be %v, but got %v", at, c.Point(0.3+at*0.7), got)
+		}
+	}
+}
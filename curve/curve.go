@@ -0,0 +1,127 @@
+// Package curve evaluates the smooth curves used for drawing and motion: quadratic and cubic
+// Bezier curves, and Catmull-Rom and B-spline curves through many points. Every curve runs
+// from t = 0 to t = 1. Flatten turns a curve into a polyline for drawing, and ArcLength
+// maps distances along a curve to positions, for moving objects along it at a steady speed.
+package curve
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+const (
+	// FLATTEN_STEPS is how many pieces every segment is split into before adaptive
+	// subdivision, so tight bends and loops between test points aren't missed.
+	FLATTEN_STEPS = 4
+	// MAX_FLATTEN_DEPTH limits subdivision of each piece, for curves with kinks.
+	MAX_FLATTEN_DEPTH = 12
+	// LENGTH_TOLERANCE is the flattening tolerance used to measure curves, in pixels.
+	LENGTH_TOLERANCE = 0.01
+)
+
+// ------------------------------------------------------------------------------------------------
+// Curve is a path from t = 0 to t = 1.
+type Curve interface {
+	// Point returns the position at t.
+	Point(t float64) vecmath.Vec2
+	// Tangent returns the derivative at t, pointing along the curve with a length that
+	// grows with its speed.
+	Tangent(t float64) vecmath.Vec2
+}
+
+// ------------------------------------------------------------------------------------------------
+// segmented curves are made of several pieces, which each get FLATTEN_STEPS pieces to start.
+type segmented interface {
+	Segments() int
+}
+
+// ------------------------------------------------------------------------------------------------
+// Flatten returns points along the curve, from its start to its end, so that the polyline
+// through them is never more than tolerance away from the curve. Gentle stretches get few
+// points and tight bends many.
+func Flatten(c Curve, tolerance float64) []vecmath.Vec2 {
+	var points []vecmath.Vec2
+	flatten(c, tolerance, false, func(_ float64, p vecmath.Vec2) {
+		points = append(points, p)
+	})
+	return points
+}
+
+// ------------------------------------------------------------------------------------------------
+// Length measures the curve, to within a small fraction of a pixel.
+func Length(c Curve) float64 {
+	length := 0.0
+	var previous vecmath.Vec2
+	flatten(c, LENGTH_TOLERANCE, false, func(t float64, p vecmath.Vec2) {
+		if t > 0 {
+			length += p.Distance(previous)
+		}
+		previous = p
+	})
+	return length
+}
+
+// ------------------------------------------------------------------------------------------------
+// flatten calls visit with the parameter and position of every polyline point in order. With
+// even set, pieces are also split until t moves along them at a steady pace, so positions
+// can be found by interpolating t along the polyline.
+func flatten(c Curve, tolerance float64, even bool, visit func(t float64, p vecmath.Vec2)) {
+	tolerance = max(tolerance, 1e-6)
+
+	steps := FLATTEN_STEPS
+	if s, ok := c.(segmented); ok {
+		steps *= max(1, s.Segments())
+	}
+
+	start := c.Point(0)
+	visit(0, start)
+
+	for i := range steps {
+		t0, t1 := float64(i)/float64(steps), float64(i+1)/float64(steps)
+		end := c.Point(t1)
+		subdivide(c, tolerance, even, t0, t1, start, end, 0, visit)
+		start = end
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// subdivide splits the piece from t0 to t1 in half until the curve stays close to the chord
+// at its quarter points, then visits the end of each piece.
+func subdivide(c Curve, tolerance float64, even bool, t0, t1 float64, p0, p1 vecmath.Vec2, depth int, visit func(float64, vecmath.Vec2)) {
+	middle := (t0 + t1) / 2
+	pm := c.Point(middle)
+
+	if depth < MAX_FLATTEN_DEPTH {
+		flat := true
+		for i, p := range []vecmath.Vec2{c.Point((t0 + middle) / 2), pm, c.Point((middle + t1) / 2)} {
+			distance := segmentDistance(p, p0, p1)
+			if even {
+				distance = p.Distance(p0.Lerp(p1, float64(i+1)/4))
+			}
+			flat = flat && distance <= tolerance
+		}
+
+		if !flat {
+			subdivide(c, tolerance, even, t0, middle, p0, pm, depth+1, visit)
+			subdivide(c, tolerance, even, middle, t1, pm, p1, depth+1, visit)
+			return
+		}
+	}
+
+	visit(t1, p1)
+}
+
+// ------------------------------------------------------------------------------------------------
+// segmentDistance returns how far p is from the line segment between a and b.
+func segmentDistance(p, a, b vecmath.Vec2) float64 {
+	ab := b.Sub(a)
+	lengthSquared := ab.LengthSquared()
+	if lengthSquared == 0 {
+		return p.Distance(a)
+	}
+
+	t := math.Max(0, math.Min(1, p.Sub(a).Dot(ab)/lengthSquared))
+	return p.Distance(a.Add(ab.Scale(t)))
+}
@@ -0,0 +1,95 @@
+package curve
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+const tolerance = 1e-9
+
+// ------------------------------------------------------------------------------------------------
+func near(a, b vecmath.Vec2, within float64) bool {
+	return math.Abs(a.X-b.X) <= within && math.Abs(a.Y-b.Y) <= within
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name      string
+		curve     Curve
+		tolerance float64
+	}{
+		{"gentle quadratic", Quadratic{P1: vecmath.Vec2{X: 50, Y: 10}, P2: vecmath.Vec2{X: 100}}, 0.25},
+		{"s-shaped cubic", Cubic{P1: vecmath.Vec2{X: 100}, P2: vecmath.Vec2{Y: 100}, P3: vecmath.Vec2{X: 100, Y: 100}}, 0.25},
+		{"looping cubic", Cubic{P1: vecmath.Vec2{X: 200, Y: 100}, P2: vecmath.Vec2{X: -100, Y: 100}, P3: vecmath.Vec2{X: 100}}, 0.1},
+		{"catmull-rom", NewCatmullRom([]vecmath.Vec2{{}, {X: 30, Y: 40}, {X: 60}, {X: 90, Y: 40}}), 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := Flatten(tt.curve, tt.tolerance)
+
+			if !near(points[0], tt.curve.Point(0), tolerance) || !near(points[len(points)-1], tt.curve.Point(1), tolerance) {
+				t.Fatalf("Expected the polyline to start and end with the curve, but got %v and %v", points[0], points[len(points)-1])
+			}
+
+			// every point on the curve should be close to some segment of the polyline
+			for i := range 1001 {
+				p := tt.curve.Point(float64(i) / 1000)
+				closest := math.Inf(1)
+				for j := 1; j < len(points); j++ {
+					closest = math.Min(closest, segmentDistance(p, points[j-1], points[j]))
+				}
+				if closest > tt.tolerance*1.01 {
+					t.Fatalf("Expected the curve to stay within %v of the polyline, but %v is %v away", tt.tolerance, p, closest)
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFlattenIsAdaptive(t *testing.T) {
+	straight := Cubic{P1: vecmath.Vec2{X: 30}, P2: vecmath.Vec2{X: 60}, P3: vecmath.Vec2{X: 90}}
+	bent := Cubic{P1: vecmath.Vec2{Y: 90}, P2: vecmath.Vec2{X: 90, Y: 90}, P3: vecmath.Vec2{X: 90}}
+
+	if got := len(Flatten(straight, 0.25)); got != FLATTEN_STEPS+1 {
+		t.Errorf("Expected a straight curve to need no subdivision, but got %d points", got)
+	}
+
+	if loose, tight := len(Flatten(bent, 1)), len(Flatten(bent, 0.01)); tight <= loose {
+		t.Errorf("Expected a tighter tolerance to use more points, but got %d and %d", loose, tight)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		curve    Curve
+		expected float64
+	}{
+		{"straight quadratic", Quadratic{P1: vecmath.Vec2{X: 5}, P2: vecmath.Vec2{X: 10}}, 10},
+		{"straight cubic", Cubic{P1: vecmath.Vec2{X: 3, Y: 4}, P2: vecmath.Vec2{X: 6, Y: 8}, P3: vecmath.Vec2{X: 9, Y: 12}}, 15},
+		// the standard cubic approximation of a quarter circle is within 0.03% of its length
+		{"quarter circle", Cubic{
+			P0: vecmath.Vec2{X: 100},
+			P1: vecmath.Vec2{X: 100, Y: 55.228475},
+			P2: vecmath.Vec2{X: 55.228475, Y: 100},
+			P3: vecmath.Vec2{Y: 100},
+		}, 50 * math.Pi},
+		{"spline through a line", NewCatmullRom([]vecmath.Vec2{{}, {X: 10}, {X: 20}}), 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Length(tt.curve)
+			if math.Abs(got-tt.expected) > tt.expected*0.0005 {
+				t.Errorf("Expected length %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
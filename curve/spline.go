@@ -0,0 +1,126 @@
+package curve
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Spline is a chain of cubic Bezier segments joined smoothly end to end. Each segment gets
+// an equal share of t, however long it is.
+type Spline struct {
+	segments []Cubic
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewCatmullRom creates a spline passing through every point, like a rope threaded through
+// pegs. The ends are extended by repeating the first and last points.
+func NewCatmullRom(points []vecmath.Vec2) *Spline {
+	if len(points) < 2 {
+		return single(points)
+	}
+
+	padded := pad(points, 1)
+	s := &Spline{segments: make([]Cubic, len(points)-1)}
+	for i := range s.segments {
+		p0, p1, p2, p3 := padded[i], padded[i+1], padded[i+2], padded[i+3]
+
+		// the tangent at each point is parallel to the line between its neighbours
+		s.segments[i] = Cubic{
+			P0: p1,
+			P1: p1.Add(p2.Sub(p0).Scale(1.0 / 6)),
+			P2: p2.Sub(p3.Sub(p1).Scale(1.0 / 6)),
+			P3: p2,
+		}
+	}
+	return s
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewBSpline creates a uniform cubic B-spline, which is smoother than Catmull-Rom but only
+// passes near the points, like a flexible ruler pulled towards them. It starts and ends
+// exactly on the first and last points, which are repeated to pin it down.
+func NewBSpline(points []vecmath.Vec2) *Spline {
+	if len(points) < 2 {
+		return single(points)
+	}
+
+	padded := pad(points, 2)
+	s := &Spline{segments: make([]Cubic, len(padded)-3)}
+	for i := range s.segments {
+		p0, p1, p2, p3 := padded[i], padded[i+1], padded[i+2], padded[i+3]
+
+		s.segments[i] = Cubic{
+			P0: p0.Add(p1.Scale(4)).Add(p2).Scale(1.0 / 6),
+			P1: p1.Scale(2).Add(p2).Scale(1.0 / 3),
+			P2: p1.Add(p2.Scale(2)).Scale(1.0 / 3),
+			P3: p1.Add(p2.Scale(4)).Add(p3).Scale(1.0 / 6),
+		}
+	}
+	return s
+}
+
+// ------------------------------------------------------------------------------------------------
+// single is the spline for fewer than two points, which stays put.
+func single(points []vecmath.Vec2) *Spline {
+	if len(points) == 0 {
+		return &Spline{}
+	}
+	p := points[0]
+	return &Spline{segments: []Cubic{{P0: p, P1: p, P2: p, P3: p}}}
+}
+
+// ------------------------------------------------------------------------------------------------
+// pad repeats the end points count extra times.
+func pad(points []vecmath.Vec2, count int) []vecmath.Vec2 {
+	padded := make([]vecmath.Vec2, 0, len(points)+2*count)
+	for range count {
+		padded = append(padded, points[0])
+	}
+	padded = append(padded, points...)
+	for range count {
+		padded = append(padded, points[len(points)-1])
+	}
+	return padded
+}
+
+// ------------------------------------------------------------------------------------------------
+// Segments returns the Bezier segments making up the spline.
+func (s *Spline) Segments() int {
+	return len(s.segments)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Segment returns one of the Bezier segments.
+func (s *Spline) Segment(i int) Cubic {
+	return s.segments[i]
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *Spline) Point(t float64) vecmath.Vec2 {
+	if len(s.segments) == 0 {
+		return vecmath.Vec2{}
+	}
+	segment, local := s.locate(t)
+	return s.segments[segment].Point(local)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *Spline) Tangent(t float64) vecmath.Vec2 {
+	if len(s.segments) == 0 {
+		return vecmath.Vec2{}
+	}
+
+	// each segment covers 1/n of t, so it moves n times faster
+	segment, local := s.locate(t)
+	return s.segments[segment].Tangent(local).Scale(float64(len(s.segments)))
+}
+
+// ------------------------------------------------------------------------------------------------
+// locate finds the segment t falls in and how far along it t is.
+func (s *Spline) locate(t float64) (int, float64) {
+	scaled := math.Max(0, math.Min(1, t)) * float64(len(s.segments))
+	segment := min(int(scaled), len(s.segments)-1)
+	return segment, scaled - float64(segment)
+}
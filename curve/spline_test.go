@@ -0,0 +1,100 @@
+package curve
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+var splinePoints = []vecmath.Vec2{{}, {X: 10, Y: 20}, {X: 30, Y: 20}, {X: 40}, {X: 60, Y: 10}}
+
+// ------------------------------------------------------------------------------------------------
+func TestCatmullRomPassesThroughPoints(t *testing.T) {
+	s := NewCatmullRom(splinePoints)
+
+	if s.Segments() != len(splinePoints)-1 {
+		t.Fatalf("Expected %d segments, but got %d", len(splinePoints)-1, s.Segments())
+	}
+
+	for i, p := range splinePoints {
+		at := float64(i) / float64(s.Segments())
+		if got := s.Point(at); !near(got, p, tolerance) {
+			t.Errorf("Expected point %d at %v, but got %v", i, p, got)
+		}
+	}
+
+	// tangents at inner points follow the neighbours, and match across segments
+	for i := 1; i < s.Segments(); i++ {
+		out := s.Segment(i).Tangent(0)
+		in := s.Segment(i - 1).Tangent(1)
+		expected := splinePoints[i+1].Sub(splinePoints[i-1]).Scale(0.5)
+		if !near(out, expected, tolerance) || !near(in, expected, tolerance) {
+			t.Errorf("Expected tangent %v at point %d, but got %v and %v", expected, i, in, out)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestBSpline(t *testing.T) {
+	s := NewBSpline(splinePoints)
+
+	if got := s.Point(0); !near(got, splinePoints[0], tolerance) {
+		t.Errorf("Expected the spline to start at %v, but got %v", splinePoints[0], got)
+	}
+	if got := s.Point(1); !near(got, splinePoints[len(splinePoints)-1], tolerance) {
+		t.Errorf("Expected the spline to end at %v, but got %v", splinePoints[len(splinePoints)-1], got)
+	}
+
+	// a B-spline is smooth in both direction and curvature where segments meet
+	for i := 1; i < s.Segments(); i++ {
+		previous, next := s.Segment(i-1), s.Segment(i)
+		if !near(previous.P3, next.P0, tolerance) || !near(previous.Tangent(1), next.Tangent(0), tolerance) {
+			t.Errorf("Expected segments %d and %d to join smoothly", i-1, i)
+		}
+
+		curvatureIn := previous.P3.Sub(previous.P2.Scale(2)).Add(previous.P1)
+		curvatureOut := next.P2.Sub(next.P1.Scale(2)).Add(next.P0)
+		if !near(curvatureIn, curvatureOut, tolerance) {
+			t.Errorf("Expected segments %d and %d to have matching curvature, but got %v and %v", i-1, i, curvatureIn, curvatureOut)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSplineTangent(t *testing.T) {
+	s := NewCatmullRom(splinePoints)
+
+	const h = 1e-6
+	for _, at := range []float64{0.1, 0.37, 0.6, 0.9} {
+		expected := s.Point(at + h).Sub(s.Point(at - h)).Scale(1 / (2 * h))
+		if got := s.Tangent(at); !near(got, expected, 1e-3) {
+			t.Errorf("Expected tangent %v at %v, but got %v", expected, at, got)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSplineFewPoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []vecmath.Vec2
+		expected vecmath.Vec2
+	}{
+		{"no points", nil, vecmath.Vec2{}},
+		{"one point", []vecmath.Vec2{{X: 3, Y: 4}}, vecmath.Vec2{X: 3, Y: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, s := range []*Spline{NewCatmullRom(tt.points), NewBSpline(tt.points)} {
+				if got := s.Point(0.5); got != tt.expected {
+					t.Errorf("Expected %v, but got %v", tt.expected, got)
+				}
+				if got := Length(s); got != 0 {
+					t.Errorf("Expected no length, but got %v", got)
+				}
+			}
+		})
+	}
+}
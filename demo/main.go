@@ -1,54 +1,47 @@
 package main
 
 import (
-	"math"
-
 	"github.com/ewaldhorn/gogi/buffers"
 	"github.com/ewaldhorn/gogi/canvas"
 	"github.com/ewaldhorn/gogi/colour"
-	"github.com/ewaldhorn/gogi/utils"
+	"github.com/ewaldhorn/gogi/effects"
+	"github.com/ewaldhorn/gogi/lookups"
+	"github.com/ewaldhorn/gogi/parallel"
+	"github.com/ewaldhorn/gogi/timing"
 )
 
+// the size matches effects.PLASMA_SINE_TABLE_SIZE
+//go:generate go run ../cmd/gogi-gentables -package main -out tables_gen.go -tables sin -size 16384 -prefix plasma
+
 // ------------------------------------------------------------------------------------------------
 const (
 	// canvas properties
-	CANVAS_WIDTH    = 800
-	CANVAS_HEIGHT   = 600
-	HALF_WIDTH      = CANVAS_WIDTH / 2
-	HALF_HEIGHT     = CANVAS_HEIGHT / 2
-	SINE_TABLE_SIZE = 4096 * 4 // Adjust for desired precision vs. memory
+	CANVAS_WIDTH  = 800
+	CANVAS_HEIGHT = 600
+	HALF_WIDTH    = CANVAS_WIDTH / 2
+	HALF_HEIGHT   = CANVAS_HEIGHT / 2
+
+	// the plasma moves PLASMA_SPEED units per second, whatever the frame rate
+	PLASMA_SPEED = 3.0
 )
 
 // ------------------------------------------------------------------------------------------------
 var (
 	gameCanvas *canvas.GogiCanvas
 	scenario   Scenario
+	gameLoop   *timing.Loop
+	renderer   *parallel.Renderer
 	BLACK      colour.Colour
-	sineTable  [SINE_TABLE_SIZE]float64
-	sqrtTable  []float64
-	radToIndex float64
-	palette    [256]colour.Colour
+
+	// sine tables shared by everything the demo draws
+	lookupTables *lookups.LookupTables
 )
 
 // ------------------------------------------------------------------------------------------------
 type Scenario struct {
-	t                         float64
-	renderWidth, renderHeight int
-	renderBuffer              buffers.PixelBuffer
-}
-
-// ------------------------------------------------------------------------------------------------
-func fastSin(val float64) float64 {
-	idx := int(val*radToIndex) & (SINE_TABLE_SIZE - 1)
-	return sineTable[idx]
-}
-
-// ------------------------------------------------------------------------------------------------
-func fastSqrt(val float64) float64 {
-	if val < 0 || int(val) >= len(sqrtTable) {
-		return math.Sqrt(val)
-	}
-	return sqrtTable[int(val)]
+	t, previousT float64
+	renderBuffer buffers.PixelBuffer
+	plasma       *effects.Plasma
 }
 
 // ------------------------------------------------------------------------------------------------
@@ -56,27 +49,12 @@ func main() {
 	// The main function is empty as initGame and update are exported for WASM.
 }
 
-// ------------------------------------------------------------------------------------------------
-func clampByte(val float64) uint8 {
-	if val < 0 {
-		return 0
-	}
-	if val > 255 {
-		return 255
-	}
-	return uint8(val)
-}
-
 // ------------------------------------------------------------------------------------------------
 //
 //export initGame
 func initGame() {
-	// Initialize sine lookup table
-	radToIndex = SINE_TABLE_SIZE / (2 * math.Pi)
-	for i := range SINE_TABLE_SIZE {
-		angle := float64(i) / SINE_TABLE_SIZE * 2 * math.Pi // Map index to 0-2*Pi
-		sineTable[i] = math.Sin(angle)
-	}
+	// the plasma sine table is generated ahead of time, see tables_gen.go
+	lookupTables = lookups.NewLookupTables(lookups.WithSineTable(plasmaSineTable[:]))
 
 	gameCanvas = canvas.NewCanvas(CANVAS_WIDTH, CANVAS_HEIGHT)
 	gameCanvas.ClearBuffer()
@@ -87,26 +65,14 @@ func initGame() {
 
 	scenario = Scenario{
 		t:            0.0,
-		renderWidth:  CANVAS_WIDTH / 2,
-		renderHeight: CANVAS_HEIGHT / 2,
 		renderBuffer: *buffers.NewPixelBuffer(CANVAS_WIDTH/2, CANVAS_HEIGHT/2, make([]uint8, bufSize)),
+		plasma:       effects.NewPlasma(),
 	}
+	scenario.plasma.SetLookupTables(lookupTables)
+	gameLoop = timing.NewLoop(&scenario, timing.DEFAULT_STEP)
 
-	// Initialize square root lookup table
-	maxDistSq := scenario.renderWidth/2*scenario.renderWidth/2 + scenario.renderHeight/2*scenario.renderHeight/2
-	sqrtTable = make([]float64, maxDistSq+1)
-	for i := range sqrtTable {
-		sqrtTable[i] = math.Sqrt(float64(i))
-	}
-
-	// Initialize color palette
-	for i := range 256 {
-		colorInput := float64(i)
-		r := clampByte(fastSin(colorInput*0.02+0.0)*127.0 + 128.0)
-		g := clampByte(fastSin(colorInput*0.02+2.0)*64.0 + 190.0)
-		b := clampByte(fastSin(colorInput*0.02+4.0)*127.0 + 128.0)
-		palette[i] = colour.NewColour(r, g, b, 255)
-	}
+	// draws on one goroutine under TinyGo, split across CPUs in native builds
+	renderer = parallel.NewRenderer(0)
 }
 
 // ------------------------------------------------------------------------------------------------
@@ -128,53 +94,58 @@ func getBufferLength() uint32 {
 }
 
 // ------------------------------------------------------------------------------------------------
+// getDirtyRectCount returns how many areas of the canvas changed since the last upload.
 //
-//export update
-func update() {
-	scenario.t += 0.05
-	if scenario.t > 1000000 {
-		scenario.t = 0.05
-	}
-
-	renderWidth := scenario.renderWidth
-	renderHeight := scenario.renderHeight
-	renderWidthHalf := renderWidth / 2
-	renderHeightHalf := renderHeight / 2
+//export getDirtyRectCount
+func getDirtyRectCount() uint32 {
+	return gameCanvas.GetDirtyRectCount()
+}
 
-	// first calculate the smaller buffer
-	for y := range renderHeight {
-		ny := float64(y) * 0.045
-		for x := range renderWidth {
-			nx := float64(x) * 0.045
+// ------------------------------------------------------------------------------------------------
+// getDirtyRectsPointer returns the memory address of the dirty rectangles, stored as
+// int32 x, y, width, height values, so JavaScript can upload only the changed areas.
+//
+//export getDirtyRectsPointer
+func getDirtyRectsPointer() uintptr {
+	return gameCanvas.GetDirtyRectsPointer()
+}
 
-			val1 := fastSin(nx + scenario.t)
-			val2 := fastSin(ny + scenario.t*0.5)
-			val3 := fastSin((nx+ny)*0.7 + scenario.t*0.8)
+// ------------------------------------------------------------------------------------------------
+// clearDirtyRects is called by JavaScript once the dirty areas have been uploaded.
+//
+//export clearDirtyRects
+func clearDirtyRects() {
+	gameCanvas.ClearDirtyRects()
+}
 
-			dx := float64(x - renderWidthHalf)
-			dy := float64(y - renderHeightHalf)
-			dist_sq := dx*dx + dy*dy
-			dist := fastSqrt(dist_sq) * 0.01
-			val4 := fastSin(dist + scenario.t*0.3)
+// ------------------------------------------------------------------------------------------------
+// update is called by JavaScript once per frame with the seconds elapsed since the last frame.
+//
+//export update
+func update(dt float64) {
+	gameLoop.Advance(dt)
+}
 
-			plasmaValue := (val1 + val2 + val3 + val4) / 4.0
+// ------------------------------------------------------------------------------------------------
+// FixedUpdate moves the plasma along by a fixed amount of time.
+func (s *Scenario) FixedUpdate(step float64) {
+	s.previousT = s.t
+	s.t += PLASMA_SPEED * step
+	if s.t > 1000000 {
+		s.t = 0.05
+		s.previousT = s.t
+	}
+}
 
-			// Map plasma value to palette index
-			// plasmaValue is in [-1, 1]. Map it to [0, 255].
-			idx := utils.ClampIntTo(int((plasmaValue + 1.0) * 127.5), 0, 255)
+// ------------------------------------------------------------------------------------------------
+// Render draws the plasma, interpolating between the last two fixed updates.
+func (s *Scenario) Render(alpha float64) {
+	t := s.previousT + (s.t-s.previousT)*alpha
 
-			scenario.renderBuffer.ColourPutPixel(x, y, palette[idx])
-		}
-	}
+	// first calculate the smaller buffer
+	s.plasma.SetTime(t)
+	renderer.RenderEffect(s.plasma, &s.renderBuffer)
 
 	// now actually render it by upscaling
-	for y := range CANVAS_HEIGHT {
-		for x := range CANVAS_WIDTH {
-			// Map x, y from CANVAS_WIDTH/HEIGHT to RENDER_WIDTH/HEIGHT
-			srcX := x * renderWidth / CANVAS_WIDTH
-			srcY := y * renderHeight / CANVAS_HEIGHT
-			c := scenario.renderBuffer.GetPixel(srcX, srcY)
-			gameCanvas.ColourPutPixel(x, y, c)
-		}
-	}
+	gameCanvas.DrawPixels(&s.renderBuffer)
 }
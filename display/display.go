@@ -0,0 +1,60 @@
+// Package display shows the frames drawn on a GogiCanvas. The browser canvas is one backend,
+// while the terminal and PNG backends work natively, so an effect can be previewed with
+// `go run` without building for WebAssembly. The framebuffer backend drives a Linux console
+// display directly, for machines without a browser.
+package display
+
+import "github.com/ewaldhorn/gogi/canvas"
+
+// ------------------------------------------------------------------------------------------------
+// Display is an output backend for canvas frames.
+type Display interface {
+	// Show outputs the visual page of the canvas, which is the last completed frame.
+	Show(c *canvas.GogiCanvas) error
+	// Close releases anything held by the backend.
+	Close() error
+}
+
+// ------------------------------------------------------------------------------------------------
+// WasmDisplay is the browser backend. JavaScript reads the pixels straight out of WebAssembly
+// memory, so Show only records where the latest frame lives for the exported functions to
+// hand over.
+type WasmDisplay struct {
+	pointer       uintptr
+	length        uint32
+	width, height int
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewWasmDisplay() *WasmDisplay {
+	return &WasmDisplay{}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *WasmDisplay) Show(c *canvas.GogiCanvas) error {
+	d.pointer = c.GetBufferPointer()
+	d.length = c.GetBufferLength()
+	d.width, d.height = c.Width(), c.Height()
+	return nil
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *WasmDisplay) Close() error {
+	return nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// BufferPointer returns the address of the last shown frame.
+func (d *WasmDisplay) BufferPointer() uintptr {
+	return d.pointer
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *WasmDisplay) BufferLength() uint32 {
+	return d.length
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *WasmDisplay) Size() (width, height int) {
+	return d.width, d.height
+}
@@ -0,0 +1,147 @@
+package display
+
+import (
+	"bytes"
+	"fmt"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestWasmDisplay(t *testing.T) {
+	c := canvas.NewCanvas(8, 6)
+	d := NewWasmDisplay()
+
+	if err := d.Show(c); err != nil {
+		t.Fatal(err)
+	}
+
+	if d.BufferPointer() != c.GetBufferPointer() || d.BufferLength() != c.GetBufferLength() {
+		t.Error("Expected the display to expose the canvas buffer")
+	}
+
+	if w, h := d.Size(); w != 8 || h != 6 {
+		t.Errorf("Expected size 8x6, got %dx%d", w, h)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTerminalDisplay(t *testing.T) {
+	c := canvas.NewCanvas(2, 2)
+	c.ColourPutPixel(0, 0, colour.NewColour(255, 0, 0, 255))
+	c.ColourPutPixel(0, 1, colour.NewColour(0, 0, 255, 255))
+
+	var out bytes.Buffer
+	d := NewTerminalDisplay(&out, 0, 0)
+
+	if err := d.Show(c); err != nil {
+		t.Fatal(err)
+	}
+	d.Close()
+
+	text := out.String()
+	if strings.Count(text, "▀") != 2 {
+		t.Errorf("Expected 2 cells for a 2x2 canvas, got %q", text)
+	}
+
+	if !strings.Contains(text, "\x1b[38;2;255;0;0m\x1b[48;2;0;0;255m▀") {
+		t.Errorf("Expected a red over blue cell, got %q", text)
+	}
+
+	if !strings.HasSuffix(text, "\x1b[?25h") {
+		t.Error("Expected Close to show the cursor again")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTerminalImageDisplay(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol ImageProtocol
+		prefix   string
+	}{
+		{name: "sixel", protocol: ProtocolSixel, prefix: "\x1b[H\x1bPq"},
+		{name: "kitty", protocol: ProtocolKitty, prefix: "\x1b[H\x1b_Ga=T"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			d := NewTerminalImageDisplay(&out, tt.protocol)
+			out.Reset()
+
+			if err := d.Show(canvas.NewCanvas(4, 4)); err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.HasPrefix(out.String(), tt.prefix) {
+				t.Errorf("Expected output starting with %q, but got %q", tt.prefix, out.String())
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPNGDisplay(t *testing.T) {
+	dir := t.TempDir()
+	c := canvas.NewCanvas(4, 3)
+	c.ColourPutPixel(1, 1, colour.NewColour(10, 20, 30, 255))
+
+	path := filepath.Join(dir, "frame.png")
+	d := NewPNGDisplay(path)
+
+	for range 2 {
+		if err := d.Show(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	img, err := png.Decode(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if img.Bounds().Dx() != 4 || img.Bounds().Dy() != 3 {
+		t.Errorf("Unexpected image size %v", img.Bounds())
+	}
+
+	r, g, b, a := img.At(1, 1).RGBA()
+	if r>>8 != 10 || g>>8 != 20 || b>>8 != 30 || a>>8 != 255 {
+		t.Errorf("Unexpected pixel colour %d, %d, %d, %d", r>>8, g>>8, b>>8, a>>8)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("Expected only the frame file to remain, got %d files", len(entries))
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPNGDisplaySequence(t *testing.T) {
+	dir := t.TempDir()
+	c := canvas.NewCanvas(2, 2)
+	d := NewPNGDisplay(filepath.Join(dir, "frame-%02d.png"))
+
+	for range 3 {
+		if err := d.Show(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for i := range 3 {
+		if _, err := os.Stat(filepath.Join(dir, fmt.Sprintf("frame-%02d.png", i))); err != nil {
+			t.Errorf("Expected frame %d to exist: %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package display
+
+import (
+	"errors"
+	"io"
+
+	"github.com/ewaldhorn/gogi/canvas"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Bitfield describes where a colour component lives in a framebuffer pixel, as reported by
+// the kernel.
+type Bitfield struct {
+	Offset, Length int
+}
+
+// ------------------------------------------------------------------------------------------------
+// FramebufferInfo describes the layout of a framebuffer device. Stride is the number of bytes
+// per line, which is often more than Width times the bytes per pixel.
+type FramebufferInfo struct {
+	Width, Height    int
+	BitsPerPixel     int
+	Stride           int
+	Red, Green, Blue Bitfield
+	Transparent      Bitfield
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewFramebufferInfo returns the usual layout for a bit depth: RGB565 for 16 bits and BGR
+// byte order, blue first, for 24 and 32 bits. Lines are packed without padding.
+func NewFramebufferInfo(width, height, bitsPerPixel int) FramebufferInfo {
+	info := FramebufferInfo{
+		Width:        width,
+		Height:       height,
+		BitsPerPixel: bitsPerPixel,
+		Stride:       width * bitsPerPixel / 8,
+		Red:          Bitfield{Offset: 16, Length: 8},
+		Green:        Bitfield{Offset: 8, Length: 8},
+		Blue:         Bitfield{Offset: 0, Length: 8},
+	}
+
+	if bitsPerPixel == 16 {
+		info.Red = Bitfield{Offset: 11, Length: 5}
+		info.Green = Bitfield{Offset: 5, Length: 6}
+		info.Blue = Bitfield{Offset: 0, Length: 5}
+	}
+
+	return info
+}
+
+// ------------------------------------------------------------------------------------------------
+// FramebufferDisplay writes frames to a Linux framebuffer device, or to anything else that
+// accepts writes at an offset, like a plain file standing in for the device in tests. The
+// canvas is drawn at the top left, clipped to the framebuffer, with anything it does not
+// cover left black. Pixels are written in little endian order, which is what the kernel
+// uses on the platforms kiosks run on.
+type FramebufferDisplay struct {
+	device io.WriterAt
+	owned  io.Closer
+	info   FramebufferInfo
+	frame  []byte
+	pixels []uint8
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewFramebufferDisplay writes frames with the given layout to device. Only 16, 24 and 32
+// bits per pixel are supported.
+func NewFramebufferDisplay(device io.WriterAt, info FramebufferInfo) (*FramebufferDisplay, error) {
+	switch info.BitsPerPixel {
+	case 16, 24, 32:
+	default:
+		return nil, errors.New("unsupported framebuffer depth")
+	}
+
+	if info.Width <= 0 || info.Height <= 0 || info.Stride < info.Width*info.BitsPerPixel/8 {
+		return nil, errors.New("invalid framebuffer size")
+	}
+
+	return &FramebufferDisplay{
+		device: device,
+		info:   info,
+		frame:  make([]byte, info.Stride*info.Height),
+	}, nil
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *FramebufferDisplay) Info() FramebufferInfo {
+	return d.info
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *FramebufferDisplay) Show(c *canvas.GogiCanvas) error {
+	d.pixels = c.CopyVisibleBuffer(d.pixels)
+
+	info := d.info
+	bytesPerPixel := info.BitsPerPixel / 8
+	width, height := min(c.Width(), info.Width), min(c.Height(), info.Height)
+
+	for y := range height {
+		line := d.frame[y*info.Stride:]
+		source := d.pixels[y*c.Width()*4:]
+
+		for x := range width {
+			value := info.encode(source[x*4], source[x*4+1], source[x*4+2])
+			for b := range bytesPerPixel {
+				line[x*bytesPerPixel+b] = byte(value >> (8 * b))
+			}
+		}
+	}
+
+	_, err := d.device.WriteAt(d.frame, 0)
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// Close leaves the last frame on screen. The device itself belongs to the caller, unless it
+// was opened with OpenFramebuffer.
+func (d *FramebufferDisplay) Close() error {
+	if d.owned != nil {
+		return d.owned.Close()
+	}
+	return nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// encode packs a colour into a pixel value, dropping the low bits of each component that do
+// not fit. The transparency field, if any, is set to fully opaque.
+func (info FramebufferInfo) encode(r, g, b uint8) uint32 {
+	return pack(r, info.Red) | pack(g, info.Green) | pack(b, info.Blue) | pack(255, info.Transparent)
+}
+
+// ------------------------------------------------------------------------------------------------
+func pack(value uint8, field Bitfield) uint32 {
+	if field.Length <= 0 {
+		return 0
+	}
+	return uint32(value>>uint(8-min(8, field.Length))) << uint(field.Offset)
+}
@@ -0,0 +1,106 @@
+//go:build linux
+
+package display
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// ------------------------------------------------------------------------------------------------
+// ioctl requests from linux/fb.h
+const (
+	fbioGetVScreenInfo = 0x4600
+	fbioGetFScreenInfo = 0x4602
+)
+
+// ------------------------------------------------------------------------------------------------
+type fbBitfield struct {
+	Offset, Length, MsbRight uint32
+}
+
+// ------------------------------------------------------------------------------------------------
+// fbVarScreenInfo mirrors struct fb_var_screeninfo.
+type fbVarScreenInfo struct {
+	XRes, YRes               uint32
+	XResVirtual, YResVirtual uint32
+	XOffset, YOffset         uint32
+	BitsPerPixel             uint32
+	Grayscale                uint32
+	Red, Green, Blue, Transp fbBitfield
+	NonStd                   uint32
+	Activate                 uint32
+	Height, Width            uint32
+	AccelFlags               uint32
+	Timings                  [11]uint32
+	Reserved                 [4]uint32
+}
+
+// ------------------------------------------------------------------------------------------------
+// fbFixScreenInfo mirrors struct fb_fix_screeninfo.
+type fbFixScreenInfo struct {
+	ID                            [16]byte
+	SmemStart                     uintptr
+	SmemLen                       uint32
+	Type, TypeAux, Visual         uint32
+	XPanStep, YPanStep, YWrapStep uint16
+	LineLength                    uint32
+	MmioStart                     uintptr
+	MmioLen                       uint32
+	Accel                         uint32
+	Capabilities                  uint16
+	Reserved                      [2]uint16
+}
+
+// ------------------------------------------------------------------------------------------------
+// OpenFramebuffer opens a framebuffer device such as /dev/fb0 and asks the kernel for its
+// size, depth, stride and pixel order. Close closes the device.
+func OpenFramebuffer(path string) (*FramebufferDisplay, error) {
+	file, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var variable fbVarScreenInfo
+	var fixed fbFixScreenInfo
+	if err := ioctl(file, fbioGetVScreenInfo, unsafe.Pointer(&variable)); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if err := ioctl(file, fbioGetFScreenInfo, unsafe.Pointer(&fixed)); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	field := func(b fbBitfield) Bitfield {
+		return Bitfield{Offset: int(b.Offset), Length: int(b.Length)}
+	}
+
+	display, err := NewFramebufferDisplay(file, FramebufferInfo{
+		Width:        int(variable.XRes),
+		Height:       int(variable.YRes),
+		BitsPerPixel: int(variable.BitsPerPixel),
+		Stride:       int(fixed.LineLength),
+		Red:          field(variable.Red),
+		Green:        field(variable.Green),
+		Blue:         field(variable.Blue),
+		Transparent:  field(variable.Transp),
+	})
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	display.owned = file
+	return display, nil
+}
+
+// ------------------------------------------------------------------------------------------------
+func ioctl(file *os.File, request uintptr, arg unsafe.Pointer) error {
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, file.Fd(), request, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
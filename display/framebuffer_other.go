@@ -0,0 +1,12 @@
+//go:build !linux
+
+package display
+
+import "errors"
+
+// ------------------------------------------------------------------------------------------------
+// OpenFramebuffer is only available on Linux. Elsewhere use NewFramebufferDisplay with a
+// layout and a file.
+func OpenFramebuffer(path string) (*FramebufferDisplay, error) {
+	return nil, errors.New("framebuffer devices are only supported on linux")
+}
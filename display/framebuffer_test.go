@@ -0,0 +1,106 @@
+package display
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestFramebufferDisplay(t *testing.T) {
+	rgba := FramebufferInfo{
+		Width: 3, Height: 2, BitsPerPixel: 32, Stride: 16,
+		Red:         Bitfield{Offset: 0, Length: 8},
+		Green:       Bitfield{Offset: 8, Length: 8},
+		Blue:        Bitfield{Offset: 16, Length: 8},
+		Transparent: Bitfield{Offset: 24, Length: 8},
+	}
+
+	tests := []struct {
+		name     string
+		info     FramebufferInfo
+		expected []byte
+	}{
+		{
+			name:     "32 bit BGRX",
+			info:     NewFramebufferInfo(3, 2, 32),
+			expected: []byte{0x30, 0x20, 0x10, 0x00},
+		},
+		{
+			name:     "32 bit RGBA with padded stride",
+			info:     rgba,
+			expected: []byte{0x10, 0x20, 0x30, 0xff},
+		},
+		{
+			name:     "24 bit BGR",
+			info:     NewFramebufferInfo(3, 2, 24),
+			expected: []byte{0x30, 0x20, 0x10},
+		},
+		{
+			name: "16 bit RGB565",
+			info: NewFramebufferInfo(3, 2, 16),
+			// 0x10>>3=2, 0x20>>2=8, 0x30>>3=6 packs to 0001 0001 0000 0110
+			expected: []byte{0x06, 0x11},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "fb0")
+			file, err := os.Create(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer file.Close()
+
+			d, err := NewFramebufferDisplay(file, tt.info)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the canvas is wider than the framebuffer and gets clipped
+			c := canvas.NewCanvas(4, 1)
+			c.ColourPutPixel(1, 0, colour.NewColour(0x10, 0x20, 0x30, 255))
+
+			if err := d.Show(c); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(data) != tt.info.Stride*tt.info.Height {
+				t.Fatalf("Expected %d bytes, but got %d", tt.info.Stride*tt.info.Height, len(data))
+			}
+
+			bytesPerPixel := tt.info.BitsPerPixel / 8
+			if pixel := data[bytesPerPixel : bytesPerPixel*2]; !bytes.Equal(pixel, tt.expected) {
+				t.Errorf("Expected pixel %x, but got %x", tt.expected, pixel)
+			}
+
+			// the second line is below the canvas and stays black
+			if line := data[tt.info.Stride:]; !bytes.Equal(line, make([]byte, len(line))) {
+				t.Errorf("Expected an empty second line, but got %x", line)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFramebufferDisplayRejectsBadLayout(t *testing.T) {
+	if _, err := NewFramebufferDisplay(nil, NewFramebufferInfo(4, 4, 8)); err == nil {
+		t.Error("Expected an error for 8 bits per pixel")
+	}
+
+	info := NewFramebufferInfo(4, 4, 32)
+	info.Stride = 8
+	if _, err := NewFramebufferDisplay(nil, info); err == nil {
+		t.Error("Expected an error for a stride shorter than a line")
+	}
+}
@@ -0,0 +1,89 @@
+package display
+
+import (
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ewaldhorn/gogi/canvas"
+)
+
+// ------------------------------------------------------------------------------------------------
+// PNGDisplay writes frames to PNG files. With a plain path every frame replaces the previous
+// one, written to a temporary file first and then renamed so an image viewer watching the
+// file never sees half a frame. A path containing a %d verb, like "frame-%04d.png", writes a
+// numbered file per frame instead.
+//
+// Alpha is ignored and every pixel is written fully opaque, with empty pixels showing as
+// black like they do on the demo page.
+type PNGDisplay struct {
+	path     string
+	sequence bool
+	frame    int
+	image    *image.NRGBA
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewPNGDisplay(path string) *PNGDisplay {
+	return &PNGDisplay{
+		path:     path,
+		sequence: strings.Contains(path, "%"),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *PNGDisplay) Show(c *canvas.GogiCanvas) error {
+	if d.image == nil || d.image.Rect.Dx() != c.Width() || d.image.Rect.Dy() != c.Height() {
+		d.image = image.NewNRGBA(image.Rect(0, 0, c.Width(), c.Height()))
+	}
+
+	d.image.Pix = c.CopyVisibleBuffer(d.image.Pix)
+	for i := 3; i < len(d.image.Pix); i += 4 {
+		d.image.Pix[i] = 255
+	}
+
+	path := d.path
+	if d.sequence {
+		path = fmt.Sprintf(d.path, d.frame)
+	}
+	d.frame++
+
+	if d.sequence {
+		return writePNG(path, d.image)
+	}
+
+	temp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := writePNG(temp, d.image); err != nil {
+		return err
+	}
+	return os.Rename(temp, path)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *PNGDisplay) Close() error {
+	return nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// Frames returns the number of frames written so far.
+func (d *PNGDisplay) Frames() int {
+	return d.frame
+}
+
+// ------------------------------------------------------------------------------------------------
+func writePNG(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
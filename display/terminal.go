@@ -0,0 +1,53 @@
+package display
+
+import (
+	"io"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/terminal"
+)
+
+// ------------------------------------------------------------------------------------------------
+const escape = "\x1b["
+
+// ------------------------------------------------------------------------------------------------
+// TerminalDisplay draws frames in the terminal using the terminal package renderer, two
+// pixels per character cell, redrawing only the cells that changed between frames.
+type TerminalDisplay struct {
+	out      io.Writer
+	renderer *terminal.Renderer
+	pixels   []uint8
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewTerminalDisplay writes truecolour frames to w, scaled to fit columns x rows character
+// cells. Pass 0 for both to draw the canvas at one pixel per column and two pixels per row.
+func NewTerminalDisplay(w io.Writer, columns, rows int) *TerminalDisplay {
+	renderer := terminal.NewRenderer(terminal.TrueColour)
+	renderer.SetSize(columns, rows)
+
+	// hide the cursor while animating
+	io.WriteString(w, escape+"?25l")
+
+	return &TerminalDisplay{out: w, renderer: renderer}
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetColourMode switches to the 256 or 16 colour palette for terminals without truecolour.
+func (d *TerminalDisplay) SetColourMode(mode terminal.ColourMode) {
+	d.renderer.SetColourMode(mode)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *TerminalDisplay) Show(c *canvas.GogiCanvas) error {
+	d.pixels = c.CopyVisibleBuffer(d.pixels)
+	return d.renderer.Render(d.out, buffers.WrapPixelBuffer(c.Width(), c.Height(), d.pixels))
+}
+
+// ------------------------------------------------------------------------------------------------
+// Close resets the colours and shows the cursor again.
+func (d *TerminalDisplay) Close() error {
+	_, err := io.WriteString(d.out, escape+"0m"+escape+"?25h")
+	return err
+}
@@ -0,0 +1,58 @@
+package display
+
+import (
+	"io"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/terminal"
+)
+
+// ------------------------------------------------------------------------------------------------
+type ImageProtocol int
+
+const (
+	ProtocolSixel ImageProtocol = iota
+	ProtocolKitty
+)
+
+// ------------------------------------------------------------------------------------------------
+// TerminalImageDisplay shows every frame as a real image at the top left of the terminal,
+// using the Sixel or Kitty graphics protocol.
+type TerminalImageDisplay struct {
+	out      io.Writer
+	protocol ImageProtocol
+	pixels   []uint8
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewTerminalImageDisplay writes frames to w using the given protocol. The screen is cleared
+// and the cursor hidden first.
+func NewTerminalImageDisplay(w io.Writer, protocol ImageProtocol) *TerminalImageDisplay {
+	io.WriteString(w, escape+"2J"+escape+"?25l")
+
+	return &TerminalImageDisplay{out: w, protocol: protocol}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (d *TerminalImageDisplay) Show(c *canvas.GogiCanvas) error {
+	d.pixels = c.CopyVisibleBuffer(d.pixels)
+	src := buffers.WrapPixelBuffer(c.Width(), c.Height(), d.pixels)
+
+	// draw over the previous frame rather than scrolling
+	if _, err := io.WriteString(d.out, escape+"H"); err != nil {
+		return err
+	}
+
+	if d.protocol == ProtocolKitty {
+		return terminal.EncodeKitty(d.out, src)
+	}
+	return terminal.EncodeSixel(d.out, src, terminal.MAX_SIXEL_COLOURS)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Close shows the cursor again.
+func (d *TerminalImageDisplay) Close() error {
+	_, err := io.WriteString(d.out, escape+"?25h")
+	return err
+}
@@ -0,0 +1,217 @@
+/*
+ Reusable loader for gogi apps built with the wasmhost package.
+
+ Usage:
+   const host = new GogiHost(document.getElementById("myCanvas"), "app.wasm");
+   host.onFps = (fps) => (fpsCounter.textContent = `FPS: ${fps}`);
+   host.start();
+
+ Set `resizable: true` in the options to follow the size of the canvas element on the page
+ instead of using its width and height attributes.
+ */
+class GogiHost {
+  constructor(canvas, wasmUrl, options = {}) {
+    this.canvas = canvas;
+    this.wasmUrl = wasmUrl;
+    this.resizable = options.resizable || false;
+    this.ctx = canvas.getContext("2d");
+    this.onFps = null;
+
+    this.exports = null;
+    this.memory = null;
+    this.bufferPointer = 0;
+    this.imageData = null;
+
+    this.lastFrameTime = 0;
+    this.frameCount = 0;
+    this.lastFpsUpdateTime = 0;
+  }
+
+  async start() {
+    const response = await fetch(this.wasmUrl);
+    const buffer = await response.arrayBuffer();
+
+    /*
+     Since we're not using the offical wasm_exec script, we need to provide
+     some functionality for the wasm module.
+     */
+    const wasiImports = {
+      wasi_snapshot_preview1: {
+        proc_exit: (code) => {
+          console.log(`WASM exited with code: ${code}`);
+        },
+        fd_write: (fd, iovs_ptr, iovs_len, nwritten_ptr) => {
+          console.log(`WASM fd_write called for fd ${fd}`);
+          return 0;
+        },
+        random_get: (buf_ptr, buf_len) => {
+          const memoryView = new Uint8Array(this.memory.buffer);
+          for (let i = 0; i < buf_len; i++) {
+            memoryView[buf_ptr + i] = Math.floor(Math.random() * 256);
+          }
+          return 0;
+        },
+      },
+    };
+
+    const wasm = await WebAssembly.instantiate(buffer, {
+      env: {},
+      ...wasiImports,
+    });
+
+    this.exports = wasm.instance.exports;
+    this.memory = this.exports.memory;
+
+    // main registers the app with the wasmhost package
+    if (this.exports._initialize) {
+      this.exports._initialize();
+    } else if (this.exports._start) {
+      this.exports._start();
+    }
+
+    const [width, height] = this.canvasSize();
+    this.canvas.width = width;
+    this.canvas.height = height;
+    this.exports.gogiInit(width, height);
+
+    this.attachInput();
+    if (this.resizable) {
+      new ResizeObserver(() => this.resize()).observe(this.canvas);
+    }
+
+    requestAnimationFrame((time) => this.frame(time));
+  }
+
+  canvasSize() {
+    if (!this.resizable) {
+      return [this.canvas.width, this.canvas.height];
+    }
+    const bounds = this.canvas.getBoundingClientRect();
+    return [Math.max(1, Math.round(bounds.width)), Math.max(1, Math.round(bounds.height))];
+  }
+
+  resize() {
+    const [width, height] = this.canvasSize();
+    if (width === this.canvas.width && height === this.canvas.height) {
+      return;
+    }
+    this.canvas.width = width;
+    this.canvas.height = height;
+    this.exports.gogiResize(width, height);
+    this.imageData = null;
+  }
+
+  frame(currentTime) {
+    const dt = this.lastFrameTime ? (currentTime - this.lastFrameTime) / 1000 : 0;
+    this.lastFrameTime = currentTime;
+
+    this.exports.gogiUpdate(dt);
+    this.draw();
+    this.countFps(currentTime);
+
+    requestAnimationFrame((time) => this.frame(time));
+  }
+
+  draw() {
+    const exports = this.exports;
+    const pointer = exports.gogiBufferPointer();
+
+    // the buffer moves with page flipping, resizing and memory growth
+    if (
+      !this.imageData ||
+      pointer !== this.bufferPointer ||
+      this.imageData.data.buffer !== this.memory.buffer
+    ) {
+      this.bufferPointer = pointer;
+      const width = exports.gogiBufferWidth();
+      const height = exports.gogiBufferHeight();
+      const pixels = new Uint8ClampedArray(
+        this.memory.buffer,
+        pointer,
+        exports.gogiBufferLength(),
+      );
+      this.imageData = new ImageData(pixels, width, height);
+    }
+
+    const count = exports.gogiDirtyRectCount();
+    if (count === 0) {
+      return;
+    }
+
+    const rects = new Int32Array(this.memory.buffer, exports.gogiDirtyRectsPointer(), count * 4);
+    for (let i = 0; i < count * 4; i += 4) {
+      this.ctx.putImageData(this.imageData, 0, 0, rects[i], rects[i + 1], rects[i + 2], rects[i + 3]);
+    }
+    exports.gogiClearDirtyRects();
+  }
+
+  countFps(currentTime) {
+    this.frameCount++;
+    const elapsedTime = currentTime - this.lastFpsUpdateTime;
+
+    if (elapsedTime >= 1000) {
+      if (this.onFps) {
+        this.onFps((this.frameCount / (elapsedTime / 1000)).toFixed(0));
+      }
+      this.frameCount = 0;
+      this.lastFpsUpdateTime = currentTime;
+    }
+  }
+
+  // converts page coordinates into canvas pixel coordinates
+  toCanvas(clientX, clientY) {
+    const bounds = this.canvas.getBoundingClientRect();
+    const x = ((clientX - bounds.left) * this.canvas.width) / bounds.width;
+    const y = ((clientY - bounds.top) * this.canvas.height) / bounds.height;
+    return [Math.floor(x), Math.floor(y)];
+  }
+
+  attachInput() {
+    const exports = this.exports;
+    const canvas = this.canvas;
+
+    // the canvas needs a tab index to receive keyboard focus
+    if (!canvas.hasAttribute("tabindex")) {
+      canvas.tabIndex = 0;
+    }
+
+    canvas.addEventListener("keydown", (e) => {
+      exports.gogiKeyEvent(e.keyCode, 1);
+      e.preventDefault();
+    });
+    canvas.addEventListener("keyup", (e) => {
+      exports.gogiKeyEvent(e.keyCode, 0);
+      e.preventDefault();
+    });
+
+    canvas.addEventListener("mousemove", (e) => {
+      exports.gogiMouseMove(...this.toCanvas(e.clientX, e.clientY));
+    });
+    canvas.addEventListener("mousedown", (e) => {
+      canvas.focus();
+      exports.gogiMouseButton(e.button, 1, ...this.toCanvas(e.clientX, e.clientY));
+    });
+    canvas.addEventListener("mouseup", (e) => {
+      exports.gogiMouseButton(e.button, 0, ...this.toCanvas(e.clientX, e.clientY));
+    });
+    canvas.addEventListener(
+      "wheel",
+      (e) => {
+        exports.gogiMouseWheel(e.deltaX, e.deltaY);
+        e.preventDefault();
+      },
+      { passive: false },
+    );
+
+    const touch = (phase) => (e) => {
+      for (const t of e.changedTouches) {
+        exports.gogiTouchEvent(phase, t.identifier, ...this.toCanvas(t.clientX, t.clientY));
+      }
+      e.preventDefault();
+    };
+    canvas.addEventListener("touchstart", touch(0), { passive: false });
+    canvas.addEventListener("touchmove", touch(1), { passive: false });
+    canvas.addEventListener("touchend", touch(2), { passive: false });
+    canvas.addEventListener("touchcancel", touch(2), { passive: false });
+  }
+}
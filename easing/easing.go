@@ -0,0 +1,195 @@
+// Package easing has the classic easing curves for animation. Each takes the progress of an
+// animation from 0 to 1 and returns how far along the animated value should be, starting
+// at 0 and ending at 1. The In variants start slowly, the Out variants end slowly and the
+// InOut variants do both. Back and Elastic overshoot past 0 or 1 on the way. The formulas
+// follow https://easings.net.
+package easing
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+// Func maps animation progress from 0 to 1 onto eased progress.
+type Func func(t float64) float64
+
+// ------------------------------------------------------------------------------------------------
+const (
+	// backOvershoot pulls Back curves about 10% past their ends
+	backOvershoot      = 1.70158
+	backInOutOvershoot = backOvershoot * 1.525
+
+	elasticPeriod      = 2 * math.Pi / 3
+	elasticInOutPeriod = 2 * math.Pi / 4.5
+
+	// bounce is a parabola with three smaller bounces after it
+	bounceScale = 7.5625
+	bounceWidth = 2.75
+)
+
+// ------------------------------------------------------------------------------------------------
+func Linear(t float64) float64 {
+	return t
+}
+
+// ------------------------------------------------------------------------------------------------
+func InQuad(t float64) float64 {
+	return t * t
+}
+
+// ------------------------------------------------------------------------------------------------
+func OutQuad(t float64) float64 {
+	return 1 - (1-t)*(1-t)
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutQuad(t float64) float64 {
+	if t < 0.5 {
+		return 2 * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 2)/2
+}
+
+// ------------------------------------------------------------------------------------------------
+func InCubic(t float64) float64 {
+	return t * t * t
+}
+
+// ------------------------------------------------------------------------------------------------
+func OutCubic(t float64) float64 {
+	return 1 - math.Pow(1-t, 3)
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutCubic(t float64) float64 {
+	if t < 0.5 {
+		return 4 * t * t * t
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2
+}
+
+// ------------------------------------------------------------------------------------------------
+func InSine(t float64) float64 {
+	return 1 - math.Cos(t*math.Pi/2)
+}
+
+// ------------------------------------------------------------------------------------------------
+func OutSine(t float64) float64 {
+	return math.Sin(t * math.Pi / 2)
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutSine(t float64) float64 {
+	return -(math.Cos(math.Pi*t) - 1) / 2
+}
+
+// ------------------------------------------------------------------------------------------------
+// InExpo doubles its speed every tenth of the way. The ends are exact, the curve itself
+// would miss 0 by a tiny amount.
+func InExpo(t float64) float64 {
+	if t <= 0 {
+		return 0
+	}
+	return math.Pow(2, 10*t-10)
+}
+
+// ------------------------------------------------------------------------------------------------
+func OutExpo(t float64) float64 {
+	if t >= 1 {
+		return 1
+	}
+	return 1 - math.Pow(2, -10*t)
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutExpo(t float64) float64 {
+	switch {
+	case t <= 0:
+		return 0
+	case t >= 1:
+		return 1
+	case t < 0.5:
+		return math.Pow(2, 20*t-10) / 2
+	default:
+		return (2 - math.Pow(2, -20*t+10)) / 2
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// InBack pulls back below 0 before heading to 1.
+func InBack(t float64) float64 {
+	return (backOvershoot+1)*t*t*t - backOvershoot*t*t
+}
+
+// ------------------------------------------------------------------------------------------------
+// OutBack overshoots past 1 and settles back.
+func OutBack(t float64) float64 {
+	return 1 + (backOvershoot+1)*math.Pow(t-1, 3) + backOvershoot*math.Pow(t-1, 2)
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutBack(t float64) float64 {
+	if t < 0.5 {
+		return math.Pow(2*t, 2) * ((backInOutOvershoot+1)*2*t - backInOutOvershoot) / 2
+	}
+	return (math.Pow(2*t-2, 2)*((backInOutOvershoot+1)*(t*2-2)+backInOutOvershoot) + 2) / 2
+}
+
+// ------------------------------------------------------------------------------------------------
+// InElastic winds up with growing wobbles, like pulling back a spring.
+func InElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return math.Round(t)
+	}
+	return -math.Pow(2, 10*t-10) * math.Sin((t*10-10.75)*elasticPeriod)
+}
+
+// ------------------------------------------------------------------------------------------------
+// OutElastic springs past 1 and wobbles to a stop.
+func OutElastic(t float64) float64 {
+	if t <= 0 || t >= 1 {
+		return math.Round(t)
+	}
+	return math.Pow(2, -10*t)*math.Sin((t*10-0.75)*elasticPeriod) + 1
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutElastic(t float64) float64 {
+	switch {
+	case t <= 0 || t >= 1:
+		return math.Round(t)
+	case t < 0.5:
+		return -(math.Pow(2, 20*t-10) * math.Sin((20*t-11.125)*elasticInOutPeriod)) / 2
+	default:
+		return math.Pow(2, -20*t+10)*math.Sin((20*t-11.125)*elasticInOutPeriod)/2 + 1
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// OutBounce drops to 1 and bounces a few times, like a ball hitting the floor.
+func OutBounce(t float64) float64 {
+	switch {
+	case t < 1/bounceWidth:
+		return bounceScale * t * t
+	case t < 2/bounceWidth:
+		t -= 1.5 / bounceWidth
+		return bounceScale*t*t + 0.75
+	case t < 2.5/bounceWidth:
+		t -= 2.25 / bounceWidth
+		return bounceScale*t*t + 0.9375
+	default:
+		t -= 2.625 / bounceWidth
+		return bounceScale*t*t + 0.984375
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func InBounce(t float64) float64 {
+	return 1 - OutBounce(1-t)
+}
+
+// ------------------------------------------------------------------------------------------------
+func InOutBounce(t float64) float64 {
+	if t < 0.5 {
+		return (1 - OutBounce(1-2*t)) / 2
+	}
+	return (1 + OutBounce(2*t-1)) / 2
+}
@@ -0,0 +1,107 @@
+package easing
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+const tolerance = 1e-9
+
+// ------------------------------------------------------------------------------------------------
+// families groups every curve with its In, Out and InOut variants.
+var families = []struct {
+	name           string
+	in, out, inOut Func
+	overshoots     bool
+}{
+	{name: "linear", in: Linear, out: Linear, inOut: Linear},
+	{name: "quad", in: InQuad, out: OutQuad, inOut: InOutQuad},
+	{name: "cubic", in: InCubic, out: OutCubic, inOut: InOutCubic},
+	{name: "sine", in: InSine, out: OutSine, inOut: InOutSine},
+	{name: "expo", in: InExpo, out: OutExpo, inOut: InOutExpo},
+	{name: "back", in: InBack, out: OutBack, inOut: InOutBack, overshoots: true},
+	{name: "elastic", in: InElastic, out: OutElastic, inOut: InOutElastic, overshoots: true},
+	{name: "bounce", in: InBounce, out: OutBounce, inOut: InOutBounce},
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEasingEnds(t *testing.T) {
+	for _, tt := range families {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, f := range []Func{tt.in, tt.out, tt.inOut} {
+				if math.Abs(f(0)) > tolerance || math.Abs(f(1)-1) > tolerance {
+					t.Errorf("Expected to go from 0 to 1, but got %f to %f", f(0), f(1))
+				}
+			}
+
+			if math.Abs(tt.inOut(0.5)-0.5) > tolerance {
+				t.Errorf("Expected InOut to be half way at the middle, but got %f", tt.inOut(0.5))
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEasingSymmetry(t *testing.T) {
+	for _, tt := range families {
+		t.Run(tt.name, func(t *testing.T) {
+			for i := range 101 {
+				x := float64(i) / 100
+
+				// Out is In played backwards, InOut is In for the first half and Out for the second
+				if math.Abs(tt.out(x)-(1-tt.in(1-x))) > 1e-6 {
+					t.Fatalf("Expected Out(%f) to mirror In, but got %f", x, tt.out(x))
+				}
+				if math.Abs(tt.inOut(x)-(1-tt.inOut(1-x))) > 1e-6 {
+					t.Fatalf("Expected InOut(%f) to be symmetric, but got %f", x, tt.inOut(x))
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEasingOvershoot(t *testing.T) {
+	for _, tt := range families {
+		t.Run(tt.name, func(t *testing.T) {
+			low, high := 0.0, 1.0
+			for i := range 1001 {
+				x := float64(i) / 1000
+				for _, f := range []Func{tt.in, tt.out, tt.inOut} {
+					low, high = min(low, f(x)), max(high, f(x))
+				}
+			}
+
+			overshoots := low < -tolerance || high > 1+tolerance
+			if overshoots != tt.overshoots {
+				t.Errorf("Expected overshooting to be %v, but the range was %f to %f", tt.overshoots, low, high)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEasingValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		f        Func
+		t        float64
+		expected float64
+	}{
+		{name: "in quad", f: InQuad, t: 0.5, expected: 0.25},
+		{name: "out cubic", f: OutCubic, t: 0.5, expected: 0.875},
+		{name: "in sine", f: InSine, t: 1.0 / 3, expected: 1 - math.Sqrt(3)/2},
+		{name: "in expo", f: InExpo, t: 0.9, expected: 0.5},
+		{name: "out bounce first landing", f: OutBounce, t: 1 / bounceWidth, expected: 1},
+		{name: "in back dips", f: InBack, t: 0.5, expected: -0.0876975},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f(tt.t); math.Abs(got-tt.expected) > 1e-6 {
+				t.Errorf("Expected %f, but got %f", tt.expected, got)
+			}
+		})
+	}
+}
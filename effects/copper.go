@@ -0,0 +1,98 @@
+package effects
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// CopperBar is a horizontal bar shaded from dark edges to a bright middle, swinging up and
+// down. Size and Amplitude are fractions of the buffer height.
+type CopperBar struct {
+	Colour    colour.Colour
+	Size      float64
+	Amplitude float64
+	// Speed is in radians per second.
+	Speed float64
+	Phase float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// CopperBars recreates the Amiga copper bars. Later bars are drawn over earlier ones.
+type CopperBars struct {
+	Bars       []CopperBar
+	Background colour.Colour
+
+	t    float64
+	rows []colour.Colour
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewCopperBars creates count bars in different colours, following each other.
+func NewCopperBars(count int) *CopperBars {
+	bars := make([]CopperBar, count)
+	for i := range bars {
+		r, g, b := colour.HSLToRGB(float64(i)/float64(count), 1, 0.5)
+		bars[i] = CopperBar{
+			Colour:    colour.NewColour(r, g, b, 255),
+			Size:      0.08,
+			Amplitude: 0.4,
+			Speed:     2,
+			Phase:     float64(i) * 0.4,
+		}
+	}
+
+	return &CopperBars{Bars: bars, Background: colour.NewColourBlack()}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (c *CopperBars) Update(dt float64) {
+	c.t += dt
+}
+
+// ------------------------------------------------------------------------------------------------
+func (c *CopperBars) Render(dst *buffers.PixelBuffer) {
+	c.PrepareBands(dst)
+	c.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands works out the colour of every row, with the bars drawn over each other.
+func (c *CopperBars) PrepareBands(dst *buffers.PixelBuffer) {
+	height := dst.Height()
+	if cap(c.rows) < height {
+		c.rows = make([]colour.Colour, height)
+	}
+	rows := c.rows[:height]
+	for y := range rows {
+		rows[y] = c.Background
+	}
+
+	for _, bar := range c.Bars {
+		centre := float64(height) / 2 * (1 + math.Sin(c.t*bar.Speed+bar.Phase)*bar.Amplitude*2)
+		half := bar.Size * float64(height) / 2
+
+		for y := max(0, int(centre-half)); y < min(height, int(math.Ceil(centre+half))); y++ {
+			// a cosine curve gives the rounded, metallic look
+			distance := math.Abs(float64(y)+0.5-centre) / half
+			if distance < 1 {
+				rows[y] = shade(bar.Colour, uint8(math.Cos(distance*math.Pi/2)*255))
+			}
+		}
+	}
+
+	c.rows = rows
+}
+
+// ------------------------------------------------------------------------------------------------
+func (c *CopperBars) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	width := dst.Width()
+
+	for y := max(0, y0); y < min(len(c.rows), y1); y++ {
+		for x := range width {
+			dst.ColourPutPixel(x, y, c.rows[y])
+		}
+	}
+}
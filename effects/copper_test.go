@@ -0,0 +1,33 @@
+package effects
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestCopperBarShading(t *testing.T) {
+	red := colour.NewColour(255, 0, 0, 255)
+	c := &CopperBars{
+		Bars:       []CopperBar{{Colour: red, Size: 0.2, Amplitude: 0.25}},
+		Background: colour.NewColourBlack(),
+	}
+
+	pb := buffers.NewPixelBuffer(4, 100, make([]uint8, 4*100*buffers.RGBABytesPerPixel))
+	c.Render(pb)
+
+	// with no movement the bar sits in the middle, brightest at its centre
+	if got := pb.GetPixel(2, 50); got.R < 250 {
+		t.Errorf("Expected a bright centre, but got %v", got)
+	}
+
+	if got := pb.GetPixel(2, 42); got.R == 0 || got.R >= pb.GetPixel(2, 50).R {
+		t.Errorf("Expected a darker edge, but got %v", got)
+	}
+
+	if got := pb.GetPixel(2, 20); got != colour.NewColourBlack() {
+		t.Errorf("Expected background outside the bar, but got %v", got)
+	}
+}
@@ -0,0 +1,211 @@
+package effects
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+// DOOM_FIRE_NOISE_SIZE is the number of random bytes the fire cycles through. Like the
+// original, it reads a fixed table of random numbers instead of calling the generator
+// for every cell.
+const DOOM_FIRE_NOISE_SIZE = 4096
+
+// ------------------------------------------------------------------------------------------------
+// FireSource keeps a rectangle of the fire grid burning at a fixed intensity.
+type FireSource struct {
+	X, Y, Width, Height int
+	Intensity           uint8
+}
+
+// ------------------------------------------------------------------------------------------------
+// DoomFire is the fire from the PSX version of Doom. Every step the heat of each cell
+// moves up a row, drifting sideways with the wind and a little randomness, and losing a
+// random amount on the way. Intensities go from 0 for cold to 255 for the hottest, mapped
+// through the palette.
+type DoomFire struct {
+	width, height int
+	intensity     []uint8
+	sources       []FireSource
+
+	// Decay is the most heat a cell can lose rising one row, the average loss is half that.
+	Decay int
+	// Wind is the sideways drift in cells per row, negative values blow to the left.
+	Wind float64
+	// Turbulence is how many cells the heat can randomly wander sideways per row.
+	Turbulence int
+	Palette    []colour.Colour
+
+	noise         []uint8
+	noisePosition int
+	stepper
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewDoomFire creates a width x height fire burning along the whole bottom row, simulated at
+// 60 steps per second. The decay is picked so the flames reach about half way up.
+func NewDoomFire(width, height int) *DoomFire {
+	width, height = max(1, width), max(2, height)
+
+	f := &DoomFire{
+		width:      width,
+		height:     height,
+		intensity:  make([]uint8, width*height),
+		Decay:      max(2, 1024/height),
+		Turbulence: 1,
+		Palette:    colour.GetFirePalette(),
+		noise:      randomness.GenerateRandomNumbersUInt8From(randomness.NewTimeSeededSource(), 256, DOOM_FIRE_NOISE_SIZE),
+		stepper:    stepper{rate: 60},
+	}
+
+	f.AddSource(FireSource{X: 0, Y: height - 1, Width: width, Height: 1, Intensity: 255})
+	return f
+}
+
+// ------------------------------------------------------------------------------------------------
+// Size returns the size of the intensity grid.
+func (f *DoomFire) Size() (width, height int) {
+	return f.width, f.height
+}
+
+// ------------------------------------------------------------------------------------------------
+// Intensity returns the heat of a cell, or 0 outside the grid.
+func (f *DoomFire) Intensity(x, y int) uint8 {
+	if x < 0 || x >= f.width || y < 0 || y >= f.height {
+		return 0
+	}
+	return f.intensity[y*f.width+x]
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) AddSource(source FireSource) {
+	f.sources = append(f.sources, source)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) Sources() []FireSource {
+	return f.sources
+}
+
+// ------------------------------------------------------------------------------------------------
+// ClearSources removes every source. The fire then dies down by itself, as the cells that
+// were sources cool off like the rest.
+func (f *DoomFire) ClearSources() {
+	f.sources = nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// Seed refills the random table from a source with the given seed, making the fire repeatable.
+func (f *DoomFire) Seed(seed uint64) {
+	f.SetNoise(randomness.GenerateRandomNumbersUInt8From(randomness.NewSource(seed), 256, DOOM_FIRE_NOISE_SIZE))
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetNoise replaces the random table, to make the fire repeatable.
+func (f *DoomFire) SetNoise(noise []uint8) {
+	if len(noise) > 0 {
+		f.noise = noise
+		f.noisePosition = 0
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) Update(dt float64) {
+	for range f.steps(dt) {
+		f.step()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) step() {
+	f.applySources()
+
+	width := f.width
+	// a negative decay would add heat, or divide by zero at -1
+	decay := max(0, f.Decay)
+	windCells := int(f.Wind)
+	windFraction := f.Wind - float64(windCells)
+	windChance := int(abs(windFraction) * 256)
+	windStep := 1
+	if windFraction < 0 {
+		windStep = -1
+	}
+
+	// reading a row before writing into it means every step moves heat up exactly one row
+	for y := 1; y < f.height; y++ {
+		for x := range width {
+			heat := int(f.intensity[y*width+x])
+
+			drift := windCells
+			if int(f.nextNoise()) < windChance {
+				drift += windStep
+			}
+			if f.Turbulence > 0 {
+				drift += int(f.nextNoise())%(f.Turbulence*2+1) - f.Turbulence
+			}
+
+			if heat > 0 {
+				heat -= int(f.nextNoise()) % (decay + 1)
+			}
+
+			f.intensity[(y-1)*width+wrap(x+drift, width)] = uint8(max(0, heat))
+		}
+	}
+
+	// nothing feeds the bottom row, so it cools off unless a source keeps it burning
+	bottom := f.intensity[(f.height-1)*width:]
+	for x, heat := range bottom {
+		if heat > 0 {
+			bottom[x] = uint8(max(0, int(heat)-int(f.nextNoise())%(decay+1)))
+		}
+	}
+
+	// keep the sources burning for the frame being shown
+	f.applySources()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) applySources() {
+	for _, source := range f.sources {
+		for y := max(0, source.Y); y < min(f.height, source.Y+source.Height); y++ {
+			for x := max(0, source.X); x < min(f.width, source.X+source.Width); x++ {
+				f.intensity[y*f.width+x] = source.Intensity
+			}
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) nextNoise() uint8 {
+	value := f.noise[f.noisePosition]
+	f.noisePosition++
+	if f.noisePosition == len(f.noise) {
+		f.noisePosition = 0
+	}
+	return value
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) Render(dst *buffers.PixelBuffer) {
+	f.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands has nothing to do, the grid is only read while drawing.
+func (f *DoomFire) PrepareBands(dst *buffers.PixelBuffer) {}
+
+// ------------------------------------------------------------------------------------------------
+func (f *DoomFire) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	renderGrid(dst, y0, y1, f.width, f.height, func(x, y int) colour.Colour {
+		return paletteColour(f.Palette, float64(f.intensity[y*f.width+x])/255)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func abs(value float64) float64 {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
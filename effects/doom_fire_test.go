@@ -0,0 +1,95 @@
+package effects
+
+import "testing"
+
+// ------------------------------------------------------------------------------------------------
+// rowHeat adds up the intensity of a row of the fire.
+func rowHeat(f *DoomFire, y int) int {
+	total := 0
+	for x := range f.width {
+		total += int(f.Intensity(x, y))
+	}
+	return total
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDoomFireRisesAndDecays(t *testing.T) {
+	f := NewDoomFire(40, 50)
+	f.Update(2)
+
+	if rowHeat(f, 49) != 40*255 {
+		t.Errorf("Expected the bottom row source to stay at full heat, got %d", rowHeat(f, 49))
+	}
+
+	if rowHeat(f, 40) == 0 {
+		t.Error("Expected heat to rise above the source")
+	}
+
+	if rowHeat(f, 0) >= rowHeat(f, 40) {
+		t.Errorf("Expected the top to be cooler, got %d at the top and %d lower down", rowHeat(f, 0), rowHeat(f, 40))
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDoomFireDiesWithoutSources(t *testing.T) {
+	f := NewDoomFire(20, 30)
+	f.Update(1)
+	f.ClearSources()
+	f.Update(2)
+
+	for y := range 30 {
+		if heat := rowHeat(f, y); heat != 0 {
+			t.Fatalf("Expected the fire to burn out, but row %d has heat %d", y, heat)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDoomFireSourcesAndWind(t *testing.T) {
+	tests := []struct {
+		name string
+		wind float64
+		left bool
+	}{
+		{name: "blowing left", wind: -1, left: true},
+		{name: "blowing right", wind: 0.5, left: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewDoomFire(60, 20)
+			f.ClearSources()
+			f.AddSource(FireSource{X: 28, Y: 19, Width: 4, Height: 1, Intensity: 255})
+			f.Decay = 4
+			f.Turbulence = 0
+			f.Wind = tt.wind
+			f.SetNoise([]uint8{0, 50, 100, 150, 200, 250, 25, 75, 125, 175, 225})
+			f.Update(1)
+
+			left, right := 0, 0
+			for y := range 10 {
+				for x := range 30 {
+					left += int(f.Intensity(x, y))
+					right += int(f.Intensity(x+30, y))
+				}
+			}
+
+			if (left > right) != tt.left {
+				t.Errorf("Expected the flames to lean with the wind, got %d on the left and %d on the right", left, right)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDoomFireNegativeDecay(t *testing.T) {
+	f := NewDoomFire(20, 30)
+	f.Decay = -1
+	f.Update(1)
+
+	for y := range 30 {
+		if heat := rowHeat(f, y); heat != 20*255 {
+			t.Fatalf("Expected no decay to keep every row at full heat, but row %d has heat %d", y, heat)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// Package effects contains classic demoscene effects: plasma, fire, Doom fire, starfield, tunnel,
+// rotozoomer, metaballs, water ripples and copper bars. Every effect implements Effect, so
+// a scene can advance and draw them without knowing which one it has.
+package effects
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Effect is an animated effect that draws into a PixelBuffer.
+type Effect interface {
+	// Update moves the effect on by dt seconds.
+	Update(dt float64)
+	// Render draws the current frame, covering the whole buffer.
+	Render(dst *buffers.PixelBuffer)
+}
+
+// ------------------------------------------------------------------------------------------------
+// BandRenderer is an Effect that can draw any band of rows on its own, so a frame can be
+// split across goroutines. Drawing every band gives exactly the same frame as Render.
+type BandRenderer interface {
+	Effect
+	// PrepareBands sets up anything the frame needs, before any band is drawn.
+	PrepareBands(dst *buffers.PixelBuffer)
+	// RenderBand draws the rows from y0 up to y1. Different bands may be drawn at the same
+	// time.
+	RenderBand(dst *buffers.PixelBuffer, y0, y1 int)
+}
+
+// ------------------------------------------------------------------------------------------------
+// stepper runs a simulation at a fixed number of steps per second, whatever the frame rate,
+// for effects where the look depends on the number of steps taken.
+type stepper struct {
+	rate    float64
+	pending float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// steps returns how many simulation steps are due after dt seconds.
+func (s *stepper) steps(dt float64) int {
+	s.pending += dt * s.rate
+	count := int(s.pending)
+	s.pending -= float64(count)
+	return count
+}
+
+// ------------------------------------------------------------------------------------------------
+// paletteColour maps a value from 0 to 1 onto a palette, clamping values outside the range.
+func paletteColour(palette []colour.Colour, value float64) colour.Colour {
+	if len(palette) == 0 {
+		return colour.NewColourBlack()
+	}
+
+	index := int(value * float64(len(palette)-1))
+	return palette[max(0, min(len(palette)-1, index))]
+}
+
+// ------------------------------------------------------------------------------------------------
+// shade scales the brightness of an opaque colour, with 255 leaving it unchanged.
+func shade(c colour.Colour, brightness uint8) colour.Colour {
+	return colour.NewColour(
+		uint8(uint16(c.R)*uint16(brightness)/255),
+		uint8(uint16(c.G)*uint16(brightness)/255),
+		uint8(uint16(c.B)*uint16(brightness)/255),
+		255,
+	)
+}
+
+// ------------------------------------------------------------------------------------------------
+// renderGrid draws rows y0 to y1 of a simulation grid of values onto dst, scaling it to fit
+// with nearest neighbour sampling.
+func renderGrid(dst *buffers.PixelBuffer, y0, y1, gridWidth, gridHeight int, pixel func(x, y int) colour.Colour) {
+	width, height := dst.Width(), dst.Height()
+
+	for y := max(0, y0); y < min(height, y1); y++ {
+		gridY := y * gridHeight / height
+		for x := range width {
+			dst.ColourPutPixel(x, y, pixel(x*gridWidth/width, gridY))
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package effects
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestEffectsCoverTheBuffer(t *testing.T) {
+	tests := []struct {
+		name   string
+		effect func() Effect
+	}{
+		{name: "plasma", effect: func() Effect { return NewPlasma() }},
+		{name: "fire", effect: func() Effect { f := NewFire(20, 15); f.Seed(1); return f }},
+		{name: "doom fire", effect: func() Effect {
+			f := NewDoomFire(20, 15)
+			f.SetNoise([]uint8{3, 141, 59, 26, 200, 77, 8, 250})
+			return f
+		}},
+		{name: "seeded doom fire", effect: func() Effect { f := NewDoomFire(20, 15); f.Seed(1); return f }},
+		{name: "starfield", effect: func() Effect { s := NewStarfield(50); s.Seed(1); return s }},
+		{name: "tunnel", effect: func() Effect { return NewTunnel() }},
+		{name: "rotozoomer", effect: func() Effect { return NewRotozoomer() }},
+		{name: "metaballs", effect: func() Effect { return NewMetaballs(4) }},
+		{name: "water", effect: func() Effect { w := NewWater(20, 15); w.Seed(1); return w }},
+		{name: "copper bars", effect: func() Effect { return NewCopperBars(4) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, second := tt.effect(), tt.effect()
+			pixels, other := make([]uint8, 40*30*buffers.RGBABytesPerPixel), make([]uint8, 40*30*buffers.RGBABytesPerPixel)
+			a, b := buffers.WrapPixelBuffer(40, 30, pixels), buffers.WrapPixelBuffer(40, 30, other)
+
+			for range 5 {
+				first.Update(0.1)
+				second.Update(0.1)
+			}
+			first.Render(a)
+			second.Render(b)
+
+			for i := 3; i < len(pixels); i += 4 {
+				if pixels[i] != 255 {
+					t.Fatalf("Expected every pixel to be opaque, but pixel %d has alpha %d", i/4, pixels[i])
+				}
+			}
+
+			if !bytes.Equal(pixels, other) {
+				t.Error("Expected the same frame from two effects set up the same way")
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPaletteColour(t *testing.T) {
+	palette := []colour.Colour{colour.NewColourBlack(), colour.NewColour(128, 0, 0, 255), colour.NewColourWhite()}
+
+	tests := []struct {
+		name     string
+		value    float64
+		expected colour.Colour
+	}{
+		{name: "start", value: 0, expected: palette[0]},
+		{name: "middle", value: 0.5, expected: palette[1]},
+		{name: "end", value: 1, expected: palette[2]},
+		{name: "below", value: -3, expected: palette[0]},
+		{name: "above", value: 7, expected: palette[2]},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := paletteColour(palette, tt.value); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestStepperCarriesFractions(t *testing.T) {
+	s := stepper{rate: 60}
+
+	total := 0
+	for range 10 {
+		total += s.steps(1.0 / 120)
+	}
+
+	if total != 5 {
+		t.Errorf("Expected 5 steps at 60 per second over 1/12 second, but got %d", total)
+	}
+}
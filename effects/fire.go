@@ -0,0 +1,92 @@
+package effects
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Fire is the old school fire where every cell takes the average heat of the cells below it,
+// minus a little cooling, while the bottom row flickers with random hot spots.
+type Fire struct {
+	width, height int
+	heat          []uint8
+
+	// Cooling is taken off the heat of every cell as it rises.
+	Cooling int
+	Palette []colour.Colour
+
+	random *randomness.Source
+	stepper
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewFire creates a width x height fire grid, simulated at 60 steps per second and coloured
+// with the fire palette. The grid is scaled to fit when rendered.
+func NewFire(width, height int) *Fire {
+	return &Fire{
+		width:   max(1, width),
+		height:  max(2, height),
+		heat:    make([]uint8, max(1, width)*max(2, height)),
+		Cooling: 1,
+		Palette: colour.GetFirePalette(),
+		random:  randomness.NewTimeSeededSource(),
+		stepper: stepper{rate: 60},
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Seed makes the flames repeatable.
+func (f *Fire) Seed(seed uint64) {
+	f.random.Seed(seed)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fire) Update(dt float64) {
+	for range f.steps(dt) {
+		f.step()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fire) step() {
+	width, height := f.width, f.height
+
+	bottom := f.heat[(height-1)*width:]
+	for x := range bottom {
+		if f.random.IntN(2) == 0 {
+			bottom[x] = 255
+		} else {
+			bottom[x] = 0
+		}
+	}
+
+	// work from the top down so every cell reads the previous step's values below it
+	for y := range height - 1 {
+		below := (y + 1) * width
+		twoBelow := min(y+2, height-1) * width
+
+		for x := range width {
+			left, right := (x+width-1)%width, (x+1)%width
+			sum := int(f.heat[below+left]) + int(f.heat[below+x]) + int(f.heat[below+right]) + int(f.heat[twoBelow+x])
+			f.heat[y*width+x] = uint8(max(0, sum/4-f.Cooling))
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fire) Render(dst *buffers.PixelBuffer) {
+	f.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands has nothing to do, the grid is only read while drawing.
+func (f *Fire) PrepareBands(dst *buffers.PixelBuffer) {}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fire) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	renderGrid(dst, y0, y1, f.width, f.height, func(x, y int) colour.Colour {
+		return paletteColour(f.Palette, float64(f.heat[y*f.width+x])/255)
+	})
+}
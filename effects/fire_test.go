@@ -0,0 +1,26 @@
+package effects
+
+import "testing"
+
+// ------------------------------------------------------------------------------------------------
+func TestFireRisesAndCools(t *testing.T) {
+	f := NewFire(32, 24)
+	f.Seed(7)
+	f.Update(1)
+
+	rowHeat := func(y int) int {
+		total := 0
+		for x := range f.width {
+			total += int(f.heat[y*f.width+x])
+		}
+		return total
+	}
+
+	if rowHeat(f.height-2) == 0 {
+		t.Error("Expected heat to rise from the bottom row")
+	}
+
+	if rowHeat(0) >= rowHeat(f.height-2) {
+		t.Errorf("Expected the top to be cooler than the bottom, got %d and %d", rowHeat(0), rowHeat(f.height-2))
+	}
+}
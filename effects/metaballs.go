@@ -0,0 +1,108 @@
+package effects
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Ball is one metaball. It moves along a Lissajous curve, with positions and sizes given as
+// fractions of the buffer height so the effect looks the same at any size.
+type Ball struct {
+	Radius         float64
+	SpeedX, SpeedY float64
+	PhaseX, PhaseY float64
+	RangeX, RangeY float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// Metaballs draws blobs that merge when they come close. Every pixel adds up radius²/distance²
+// for each ball, and that field strength picks the palette colour.
+type Metaballs struct {
+	Balls []Ball
+	// Threshold is the field strength at the edge of a blob, which maps to the middle of the
+	// palette.
+	Threshold float64
+	Palette   []colour.Colour
+
+	t         float64
+	positions []ballPosition
+}
+
+// ------------------------------------------------------------------------------------------------
+// ballPosition is where a ball is in the current frame, in pixels.
+type ballPosition struct {
+	x, y, radiusSquared float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewMetaballs creates count balls on different orbits.
+func NewMetaballs(count int) *Metaballs {
+	balls := make([]Ball, count)
+	for i := range balls {
+		n := float64(i + 1)
+		balls[i] = Ball{
+			Radius: 0.08 + 0.02*float64(i%3),
+			SpeedX: 0.3 + 0.13*n,
+			SpeedY: 0.4 + 0.07*n,
+			PhaseX: n * 1.7,
+			PhaseY: n * 0.9,
+			RangeX: 0.6,
+			RangeY: 0.35,
+		}
+	}
+
+	palette := make([]colour.Colour, 256)
+	for i := range palette {
+		r, g, b := colour.HSLToRGB(0.55+float64(i)/2048, 1, float64(i)/300)
+		palette[i] = colour.NewColour(r, g, b, 255)
+	}
+
+	return &Metaballs{Balls: balls, Threshold: 1, Palette: palette}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Metaballs) Update(dt float64) {
+	m.t += dt
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Metaballs) Render(dst *buffers.PixelBuffer) {
+	m.PrepareBands(dst)
+	m.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands works out where the balls are in this frame.
+func (m *Metaballs) PrepareBands(dst *buffers.PixelBuffer) {
+	width, height := dst.Width(), dst.Height()
+	scale := float64(height)
+
+	m.positions = m.positions[:0]
+	for _, ball := range m.Balls {
+		m.positions = append(m.positions, ballPosition{
+			x:             float64(width)/2 + math.Sin(m.t*ball.SpeedX+ball.PhaseX)*ball.RangeX*scale,
+			y:             float64(height)/2 + math.Cos(m.t*ball.SpeedY+ball.PhaseY)*ball.RangeY*scale,
+			radiusSquared: ball.Radius * scale * ball.Radius * scale,
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Metaballs) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	width, height := dst.Width(), dst.Height()
+
+	for y := max(0, y0); y < min(height, y1); y++ {
+		for x := range width {
+			field := 0.0
+			for _, p := range m.positions {
+				dx, dy := float64(x)-p.x, float64(y)-p.y
+				field += p.radiusSquared / max(1, dx*dx+dy*dy)
+			}
+
+			dst.ColourPutPixel(x, y, paletteColour(m.Palette, field/(2*m.Threshold)))
+		}
+	}
+}
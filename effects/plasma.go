@@ -0,0 +1,122 @@
+package effects
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/lookups"
+)
+
+// ------------------------------------------------------------------------------------------------
+// PLASMA_SINE_TABLE_SIZE is the resolution of the sine table the plasma creates for itself.
+const PLASMA_SINE_TABLE_SIZE = 4096 * 4
+
+// ------------------------------------------------------------------------------------------------
+// Plasma is the classic plasma made by adding sine waves, the effect from the demo.
+type Plasma struct {
+	// Scale is how quickly the waves change per pixel, smaller values give bigger blobs.
+	Scale float64
+	// Speed is how quickly the plasma moves, in time units per second.
+	Speed   float64
+	Palette []colour.Colour
+
+	t         float64
+	lookups   *lookups.LookupTables
+	sqrtTable []float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewPlasma creates a plasma that looks like the one in the demo. Its own sine table is only
+// built on the first frame, so sharing tables with SetLookupTables costs nothing up front.
+func NewPlasma() *Plasma {
+	return &Plasma{
+		Scale:   0.045,
+		Speed:   3,
+		Palette: PlasmaPalette(),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetLookupTables shares sine tables with other effects instead of the plasma's own.
+func (p *Plasma) SetLookupTables(tables *lookups.LookupTables) {
+	p.lookups = tables
+}
+
+// ------------------------------------------------------------------------------------------------
+// PlasmaPalette returns the 256 colour purple and green palette used by the demo.
+func PlasmaPalette() []colour.Colour {
+	return colour.GetPlasmaPalette()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *Plasma) Update(dt float64) {
+	p.t += p.Speed * dt
+}
+
+// ------------------------------------------------------------------------------------------------
+// Time returns the plasma's position in its animation.
+func (p *Plasma) Time() float64 {
+	return p.t
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetTime jumps to a point in the animation, for example to interpolate between updates.
+func (p *Plasma) SetTime(t float64) {
+	p.t = t
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *Plasma) Render(dst *buffers.PixelBuffer) {
+	p.PrepareBands(dst)
+	p.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands makes sure square roots of every distance from the centre can be looked up.
+// The distances are whole numbers, so a table indexed by them beats the general Sqrt lookup.
+// Without shared tables, the plasma's own sine table is built here the first time.
+func (p *Plasma) PrepareBands(dst *buffers.PixelBuffer) {
+	if p.lookups == nil {
+		p.lookups = lookups.NewLookupTables(lookups.WithTableSize(PLASMA_SINE_TABLE_SIZE))
+	}
+
+	halfWidth, halfHeight := dst.Width()/2, dst.Height()/2
+	maxValue := halfWidth*halfWidth + halfHeight*halfHeight
+
+	if len(p.sqrtTable) > maxValue {
+		return
+	}
+
+	p.sqrtTable = make([]float64, maxValue+1)
+	for i := range p.sqrtTable {
+		p.sqrtTable[i] = math.Sqrt(float64(i))
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *Plasma) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	width, height := dst.Width(), dst.Height()
+	halfWidth, halfHeight := width/2, height/2
+
+	t := p.t
+	for y := max(0, y0); y < min(height, y1); y++ {
+		ny := float64(y) * p.Scale
+		for x := range width {
+			nx := float64(x) * p.Scale
+
+			val1 := p.lookups.Sin(nx + t)
+			val2 := p.lookups.Sin(ny + t*0.5)
+			val3 := p.lookups.Sin((nx+ny)*0.7 + t*0.8)
+
+			dx := x - halfWidth
+			dy := y - halfHeight
+			dist := p.sqrtTable[dx*dx+dy*dy] * 0.01
+			val4 := p.lookups.Sin(dist + t*0.3)
+
+			// the sum of the waves is in [-1, 1], map it to the palette
+			plasmaValue := (val1 + val2 + val3 + val4) / 4.0
+			dst.ColourPutPixel(x, y, paletteColour(p.Palette, (plasmaValue+1.0)*0.5))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package effects
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/lookups"
+	"github.com/ewaldhorn/gogi/utils"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestPlasmaMatchesDemo(t *testing.T) {
+	p := NewPlasma()
+	p.SetTime(1.25)
+
+	pb := buffers.NewPixelBuffer(40, 30, make([]uint8, 40*30*buffers.RGBABytesPerPixel))
+	p.Render(pb)
+
+	// the demo's formula, worked out with math.Sin
+	x, y := 17, 9
+	nx, ny := float64(x)*0.045, float64(y)*0.045
+	dx, dy := float64(x-20), float64(y-15)
+	value := (math.Sin(nx+1.25) + math.Sin(ny+1.25*0.5) + math.Sin((nx+ny)*0.7+1.25*0.8) +
+		math.Sin(math.Sqrt(dx*dx+dy*dy)*0.01+1.25*0.3)) / 4
+	expected := p.Palette[utils.ClampIntTo(int((value+1)*127.5), 0, 255)]
+
+	if got := pb.GetPixel(x, y); got != expected {
+		t.Errorf("Expected %v, but got %v", expected, got)
+	}
+
+	p.Update(0.5)
+	if p.Time() != 2.75 {
+		t.Errorf("Expected time 2.75 after half a second at speed 3, but got %f", p.Time())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPlasmaBuildsSineTableOnFirstFrame(t *testing.T) {
+	p := NewPlasma()
+	if p.lookups != nil {
+		t.Fatal("Expected no sine table before the first frame")
+	}
+
+	shared := lookups.NewLookupTables(lookups.WithTableSize(1024))
+	p.SetLookupTables(shared)
+	p.Render(buffers.NewPixelBuffer(8, 8, make([]uint8, 8*8*buffers.RGBABytesPerPixel)))
+
+	if p.lookups != shared {
+		t.Error("Expected the shared tables to be kept")
+	}
+}
@@ -0,0 +1,99 @@
+package effects
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Rotozoomer spins and zooms a repeating texture around the centre of the screen.
+type Rotozoomer struct {
+	Texture *buffers.PixelBuffer
+	// RotationSpeed is in radians per second.
+	RotationSpeed float64
+	// ZoomSpeed is how fast the zoom swings between MinZoom and MaxZoom, in radians per second.
+	ZoomSpeed        float64
+	MinZoom, MaxZoom float64
+
+	t float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewRotozoomer creates a rotozoomer with a 64x64 XOR texture.
+func NewRotozoomer() *Rotozoomer {
+	return &Rotozoomer{
+		Texture:       NewXORTexture(64, colour.GetFirePalette()),
+		RotationSpeed: 0.5,
+		ZoomSpeed:     0.7,
+		MinZoom:       0.25,
+		MaxZoom:       2,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewXORTexture creates a size x size texture coloured by x XOR y through a palette, the
+// classic texture for tunnels and rotozoomers.
+func NewXORTexture(size int, palette []colour.Colour) *buffers.PixelBuffer {
+	texture := buffers.NewPixelBuffer(size, size, make([]uint8, size*size*buffers.RGBABytesPerPixel))
+
+	for y := range size {
+		for x := range size {
+			texture.ColourPutPixel(x, y, paletteColour(palette, float64((x^y)&255)/255))
+		}
+	}
+
+	return texture
+}
+
+// ------------------------------------------------------------------------------------------------
+func (r *Rotozoomer) Update(dt float64) {
+	r.t += dt
+}
+
+// ------------------------------------------------------------------------------------------------
+func (r *Rotozoomer) Render(dst *buffers.PixelBuffer) {
+	r.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands has nothing to do, every row works out its own texture coordinates.
+func (r *Rotozoomer) PrepareBands(dst *buffers.PixelBuffer) {}
+
+// ------------------------------------------------------------------------------------------------
+func (r *Rotozoomer) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	width, height := dst.Width(), dst.Height()
+	textureWidth, textureHeight := r.Texture.Width(), r.Texture.Height()
+
+	angle := r.t * r.RotationSpeed
+	zoom := r.MinZoom + (r.MaxZoom-r.MinZoom)*(math.Sin(r.t*r.ZoomSpeed)+1)/2
+	cos, sin := math.Cos(angle)*zoom, math.Sin(angle)*zoom
+
+	halfWidth, halfHeight := float64(width)/2, float64(height)/2
+	for y := max(0, y0); y < min(height, y1); y++ {
+		dy := float64(y) - halfHeight
+
+		// step across the row in texture space rather than rotating every pixel
+		u := -halfWidth*cos - dy*sin
+		v := -halfWidth*sin + dy*cos
+		for x := range width {
+			tx := wrap(int(math.Floor(u)), textureWidth)
+			ty := wrap(int(math.Floor(v)), textureHeight)
+			dst.ColourPutPixel(x, y, r.Texture.GetPixel(tx, ty))
+
+			u += cos
+			v += sin
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// wrap returns value modulo size, keeping negative values positive.
+func wrap(value, size int) int {
+	value %= size
+	if value < 0 {
+		value += size
+	}
+	return value
+}
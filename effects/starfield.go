@@ -0,0 +1,90 @@
+package effects
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+// star positions are in a box from -1 to 1 across and up, and from 0 to 1 deep.
+type star struct {
+	x, y, z float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// Starfield flies through a field of stars that get brighter as they come closer.
+type Starfield struct {
+	// Speed is how much of the field's depth is covered per second.
+	Speed      float64
+	Colour     colour.Colour
+	Background colour.Colour
+
+	stars  []star
+	random *randomness.Source
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewStarfield creates a field of count stars, a negative count gives an empty field.
+func NewStarfield(count int) *Starfield {
+	s := &Starfield{
+		Speed:      0.5,
+		Colour:     colour.NewColourWhite(),
+		Background: colour.NewColourBlack(),
+		stars:      make([]star, max(0, count)),
+		random:     randomness.NewTimeSeededSource(),
+	}
+	s.scatter()
+	return s
+}
+
+// ------------------------------------------------------------------------------------------------
+// Seed makes the field repeatable, scattering the stars again.
+func (s *Starfield) Seed(seed uint64) {
+	s.random.Seed(seed)
+	s.scatter()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *Starfield) scatter() {
+	for i := range s.stars {
+		s.respawn(&s.stars[i])
+		s.stars[i].z = s.random.Float64()*0.99 + 0.01
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// respawn puts a star back at the far end of the field.
+func (s *Starfield) respawn(st *star) {
+	st.x = s.random.Float64()*2 - 1
+	st.y = s.random.Float64()*2 - 1
+	st.z = 1
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *Starfield) Update(dt float64) {
+	for i := range s.stars {
+		st := &s.stars[i]
+		st.z -= s.Speed * dt
+
+		// stars that passed the viewer or left the screen start again in the distance
+		if st.z <= 0.01 || st.x/st.z < -1 || st.x/st.z >= 1 || st.y/st.z < -1 || st.y/st.z >= 1 {
+			s.respawn(st)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *Starfield) Render(dst *buffers.PixelBuffer) {
+	dst.Fill(s.Background)
+
+	halfWidth, halfHeight := float64(dst.Width())/2, float64(dst.Height())/2
+	for _, st := range s.stars {
+		x := int(halfWidth + st.x/st.z*halfWidth)
+		y := int(halfHeight + st.y/st.z*halfHeight)
+
+		col := s.Colour
+		col.A = uint8((1 - st.z) * 255)
+		dst.ColourPutPixel(x, y, col)
+	}
+}
@@ -0,0 +1,108 @@
+package effects
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Tunnel flies down a tunnel lined with an XOR texture. The distance and angle of every
+// pixel from the centre are worked out once per buffer size, so a frame is just lookups.
+type Tunnel struct {
+	// Speed is how many texture lengths are travelled per second.
+	Speed float64
+	// Rotation is how many turns the tunnel makes per second.
+	Rotation float64
+	// Depth sets how stretched the texture is along the tunnel, bigger is longer.
+	Depth   float64
+	Palette []colour.Colour
+
+	t             float64
+	width, height int
+	depth         float64
+	distance      []uint8
+	angle         []uint8
+	brightness    []uint8
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewTunnel() *Tunnel {
+	palette := make([]colour.Colour, 256)
+	for i := range palette {
+		r, g, b := colour.HSLToRGB(0.6+float64(i)/1024, 0.8, 0.2+float64(i)/512)
+		palette[i] = colour.NewColour(r, g, b, 255)
+	}
+
+	return &Tunnel{
+		Speed:    0.5,
+		Rotation: 0.1,
+		Depth:    32,
+		Palette:  palette,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (t *Tunnel) Update(dt float64) {
+	t.t += dt
+}
+
+// ------------------------------------------------------------------------------------------------
+func (t *Tunnel) Render(dst *buffers.PixelBuffer) {
+	t.PrepareBands(dst)
+	t.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands builds the distance, angle and brightness tables for the buffer size.
+func (t *Tunnel) PrepareBands(dst *buffers.PixelBuffer) {
+	t.prepare(dst.Width(), dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+func (t *Tunnel) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	width, height := dst.Width(), dst.Height()
+
+	shiftU := int(t.t * t.Speed * 256)
+	shiftV := int(t.t * t.Rotation * 256)
+
+	for y := max(0, y0); y < min(height, y1); y++ {
+		for x := range width {
+			i := y*width + x
+			u := (int(t.distance[i]) + shiftU) & 255
+			v := (int(t.angle[i]) + shiftV) & 255
+
+			texel := paletteColour(t.Palette, float64(u^v)/255)
+			dst.ColourPutPixel(x, y, shade(texel, t.brightness[i]))
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// prepare builds the distance, angle and brightness tables for a buffer size.
+func (t *Tunnel) prepare(width, height int) {
+	if t.width == width && t.height == height && t.Depth == t.depth {
+		return
+	}
+	t.width, t.height = width, height
+
+	t.distance = make([]uint8, width*height)
+	t.angle = make([]uint8, width*height)
+	t.brightness = make([]uint8, width*height)
+
+	halfWidth, halfHeight := float64(width)/2, float64(height)/2
+	for y := range height {
+		for x := range width {
+			dx, dy := float64(x)-halfWidth, float64(y)-halfHeight
+			radius := max(1, math.Hypot(dx, dy))
+
+			i := y*width + x
+			// things further away are closer to the centre
+			t.distance[i] = uint8(int(t.Depth*256/radius) & 255)
+			t.angle[i] = uint8(int(256*(math.Atan2(dy, dx)/(2*math.Pi)+0.5)) & 255)
+			t.brightness[i] = uint8(min(255, radius/halfHeight*255))
+		}
+	}
+	t.depth = t.Depth
+}
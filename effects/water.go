@@ -0,0 +1,135 @@
+package effects
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Water simulates ripples on a pond with two height maps: every step each cell becomes half
+// the sum of its neighbours minus its previous height, then loses a little energy. Rendering
+// either refracts a background image through the ripples or lights a flat colour by their
+// slope.
+type Water struct {
+	width, height     int
+	current, previous []float32
+
+	// DropRate is how many random drops fall per second.
+	DropRate float64
+	// Damping is the fraction of height kept each step, lower values calm down sooner.
+	Damping      float32
+	DropStrength float32
+	Colour       colour.Colour
+	// Background is seen through the water when set, scaled to the grid size.
+	Background *buffers.PixelBuffer
+
+	drops  stepper
+	random *randomness.Source
+	stepper
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewWater creates a width x height pond, simulated at 60 steps per second.
+func NewWater(width, height int) *Water {
+	width, height = max(3, width), max(3, height)
+
+	return &Water{
+		width:        width,
+		height:       height,
+		current:      make([]float32, width*height),
+		previous:     make([]float32, width*height),
+		DropRate:     4,
+		Damping:      0.985,
+		DropStrength: 512,
+		Colour:       colour.NewColour(20, 70, 140, 255),
+		drops:        stepper{rate: 1},
+		random:       randomness.NewTimeSeededSource(),
+		stepper:      stepper{rate: 60},
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Seed makes the random drops repeatable.
+func (w *Water) Seed(seed uint64) {
+	w.random.Seed(seed)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Disturb drops something into the water at x,y in grid coordinates.
+func (w *Water) Disturb(x, y int, strength float32) {
+	if x < 1 || x >= w.width-1 || y < 1 || y >= w.height-1 {
+		return
+	}
+	w.current[y*w.width+x] += strength
+}
+
+// ------------------------------------------------------------------------------------------------
+func (w *Water) Update(dt float64) {
+	w.drops.rate = w.DropRate
+	for range w.drops.steps(dt) {
+		w.Disturb(1+w.random.IntN(w.width-2), 1+w.random.IntN(w.height-2), w.DropStrength)
+	}
+
+	for range w.steps(dt) {
+		w.step()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (w *Water) step() {
+	width := w.width
+
+	// the previous heights are replaced in place by the next ones, then the maps swap
+	for y := 1; y < w.height-1; y++ {
+		for x := 1; x < width-1; x++ {
+			i := y*width + x
+			next := (w.current[i-1]+w.current[i+1]+w.current[i-width]+w.current[i+width])/2 - w.previous[i]
+			w.previous[i] = next * w.Damping
+		}
+	}
+
+	w.current, w.previous = w.previous, w.current
+}
+
+// ------------------------------------------------------------------------------------------------
+func (w *Water) Render(dst *buffers.PixelBuffer) {
+	w.RenderBand(dst, 0, dst.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// PrepareBands has nothing to do, the grid is only read while drawing.
+func (w *Water) PrepareBands(dst *buffers.PixelBuffer) {}
+
+// ------------------------------------------------------------------------------------------------
+func (w *Water) RenderBand(dst *buffers.PixelBuffer, y0, y1 int) {
+	renderGrid(dst, y0, y1, w.width, w.height, func(x, y int) colour.Colour {
+		slopeX, slopeY := w.slope(x, y)
+
+		if w.Background != nil {
+			backgroundX := (x + int(slopeX/8)) * w.Background.Width() / w.width
+			backgroundY := (y + int(slopeY/8)) * w.Background.Height() / w.height
+			col := w.Background.GetPixel(
+				max(0, min(w.Background.Width()-1, backgroundX)),
+				max(0, min(w.Background.Height()-1, backgroundY)),
+			)
+			col.A = 255
+			return col
+		}
+
+		// light comes from the top left
+		brightness := 192 - int(slopeX+slopeY)
+		return shade(w.Colour, uint8(max(0, min(255, brightness))))
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+// slope returns the height difference across a cell, zero at the edges.
+func (w *Water) slope(x, y int) (float32, float32) {
+	if x < 1 || x >= w.width-1 || y < 1 || y >= w.height-1 {
+		return 0, 0
+	}
+
+	i := y*w.width + x
+	return w.current[i+1] - w.current[i-1], w.current[i+w.width] - w.current[i-w.width]
+}
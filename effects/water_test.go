@@ -0,0 +1,41 @@
+package effects
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestWaterRipplesSpreadAndSettle(t *testing.T) {
+	w := NewWater(21, 21)
+	w.DropRate = 0
+	w.Disturb(10, 10, 1000)
+
+	w.Update(3.0 / 60)
+	if w.current[10*21+13] == 0 {
+		t.Error("Expected the ripple to reach three cells away after three steps")
+	}
+
+	w.Update(20)
+	for i, height := range w.current {
+		if height > 1 || height < -1 {
+			t.Fatalf("Expected the water to settle, but cell %d has height %f", i, height)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestWaterRefractsBackground(t *testing.T) {
+	w := NewWater(8, 8)
+	w.Background = buffers.NewPixelBuffer(8, 8, make([]uint8, 8*8*buffers.RGBABytesPerPixel))
+	w.Background.Fill(colour.NewColour(1, 2, 3, 0))
+
+	pb := buffers.NewPixelBuffer(8, 8, make([]uint8, 8*8*buffers.RGBABytesPerPixel))
+	w.Render(pb)
+
+	if got := pb.GetPixel(4, 4); got != colour.NewColour(1, 2, 3, 255) {
+		t.Errorf("Expected the opaque background colour through still water, but got %v", got)
+	}
+}
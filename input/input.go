@@ -0,0 +1,55 @@
+// Package input collects keyboard, mouse and touch events from the host and offers them both
+// as an event queue and as polled state. All positions are in canvas pixel coordinates.
+package input
+
+// ------------------------------------------------------------------------------------------------
+// EventKind identifies the type of input event.
+type EventKind int
+
+const (
+	EventKeyDown EventKind = iota
+	EventKeyUp
+	EventMouseMove
+	EventMouseDown
+	EventMouseUp
+	EventMouseWheel
+	EventTouchStart
+	EventTouchMove
+	EventTouchEnd
+)
+
+// ------------------------------------------------------------------------------------------------
+// Event is a single input event. Code holds the key code for keyboard events, the button for
+// mouse button events and the touch identifier for touch events.
+type Event struct {
+	Kind           EventKind
+	Code           int
+	X, Y           int
+	DeltaX, DeltaY float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// Key codes match the browser keyCode values. Letters and digits use their upper case
+// ASCII value, so 'A' and '0' can be used directly.
+const (
+	KeyBackspace = 8
+	KeyTab       = 9
+	KeyEnter     = 13
+	KeyShift     = 16
+	KeyControl   = 17
+	KeyAlt       = 18
+	KeyEscape    = 27
+	KeySpace     = 32
+	KeyLeft      = 37
+	KeyUp        = 38
+	KeyRight     = 39
+	KeyDown      = 40
+)
+
+// ------------------------------------------------------------------------------------------------
+// Mouse buttons match the browser button numbers.
+const (
+	MouseLeft   = 0
+	MouseMiddle = 1
+	MouseRight  = 2
+)
@@ -0,0 +1,184 @@
+package input
+
+// ------------------------------------------------------------------------------------------------
+// MAX_QUEUED_EVENTS limits the event queue. When it is full the oldest event is dropped, so an
+// app that only polls state never grows the queue without bound.
+const MAX_QUEUED_EVENTS = 256
+
+// ------------------------------------------------------------------------------------------------
+// Touch is an active touch point.
+type Touch struct {
+	ID, X, Y int
+}
+
+// ------------------------------------------------------------------------------------------------
+// Manager keeps the event queue and the current input state. Feed it with HandleEvent and
+// call EndFrame once per frame to reset the per frame state such as pressed keys and the
+// wheel movement.
+type Manager struct {
+	queue []Event
+
+	keysDown     map[int]bool
+	keysPressed  map[int]bool
+	keysReleased map[int]bool
+
+	mouseX, mouseY int
+	buttonsDown    uint32
+	buttonsPressed uint32
+	wheelX, wheelY float64
+
+	touches []Touch
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewManager() *Manager {
+	return &Manager{
+		keysDown:     make(map[int]bool),
+		keysPressed:  make(map[int]bool),
+		keysReleased: make(map[int]bool),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// HandleEvent updates the input state and queues the event.
+func (m *Manager) HandleEvent(e Event) {
+	switch e.Kind {
+	case EventKeyDown:
+		// browsers repeat key down events while a key is held
+		if !m.keysDown[e.Code] {
+			m.keysPressed[e.Code] = true
+		}
+		m.keysDown[e.Code] = true
+	case EventKeyUp:
+		delete(m.keysDown, e.Code)
+		m.keysReleased[e.Code] = true
+	case EventMouseMove:
+		m.mouseX, m.mouseY = e.X, e.Y
+	case EventMouseDown:
+		m.mouseX, m.mouseY = e.X, e.Y
+		m.buttonsDown |= buttonMask(e.Code)
+		m.buttonsPressed |= buttonMask(e.Code)
+	case EventMouseUp:
+		m.mouseX, m.mouseY = e.X, e.Y
+		m.buttonsDown &^= buttonMask(e.Code)
+	case EventMouseWheel:
+		m.wheelX += e.DeltaX
+		m.wheelY += e.DeltaY
+	case EventTouchStart, EventTouchMove:
+		m.setTouch(Touch{ID: e.Code, X: e.X, Y: e.Y})
+	case EventTouchEnd:
+		m.removeTouch(e.Code)
+	}
+
+	if len(m.queue) >= MAX_QUEUED_EVENTS {
+		m.queue = append(m.queue[:0], m.queue[1:]...)
+	}
+	m.queue = append(m.queue, e)
+}
+
+// ------------------------------------------------------------------------------------------------
+// PollEvent removes and returns the oldest queued event, if there is one.
+func (m *Manager) PollEvent() (Event, bool) {
+	if len(m.queue) == 0 {
+		return Event{}, false
+	}
+
+	e := m.queue[0]
+	m.queue = append(m.queue[:0], m.queue[1:]...)
+	return e, true
+}
+
+// ------------------------------------------------------------------------------------------------
+// Events removes and returns all the queued events, oldest first.
+func (m *Manager) Events() []Event {
+	events := make([]Event, len(m.queue))
+	copy(events, m.queue)
+	m.queue = m.queue[:0]
+	return events
+}
+
+// ------------------------------------------------------------------------------------------------
+// EndFrame clears the state that only lasts for a single frame.
+func (m *Manager) EndFrame() {
+	clear(m.keysPressed)
+	clear(m.keysReleased)
+	m.buttonsPressed = 0
+	m.wheelX, m.wheelY = 0, 0
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Manager) IsKeyDown(code int) bool {
+	return m.keysDown[code]
+}
+
+// ------------------------------------------------------------------------------------------------
+// IsKeyPressed reports whether the key went down during this frame.
+func (m *Manager) IsKeyPressed(code int) bool {
+	return m.keysPressed[code]
+}
+
+// ------------------------------------------------------------------------------------------------
+// IsKeyReleased reports whether the key went up during this frame.
+func (m *Manager) IsKeyReleased(code int) bool {
+	return m.keysReleased[code]
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Manager) MousePosition() (x, y int) {
+	return m.mouseX, m.mouseY
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Manager) IsMouseButtonDown(button int) bool {
+	return m.buttonsDown&buttonMask(button) != 0
+}
+
+// ------------------------------------------------------------------------------------------------
+// IsMouseButtonPressed reports whether the button went down during this frame.
+func (m *Manager) IsMouseButtonPressed(button int) bool {
+	return m.buttonsPressed&buttonMask(button) != 0
+}
+
+// ------------------------------------------------------------------------------------------------
+// Wheel returns the wheel movement accumulated during this frame.
+func (m *Manager) Wheel() (dx, dy float64) {
+	return m.wheelX, m.wheelY
+}
+
+// ------------------------------------------------------------------------------------------------
+// Touches returns the active touch points in the order they started.
+func (m *Manager) Touches() []Touch {
+	touches := make([]Touch, len(m.touches))
+	copy(touches, m.touches)
+	return touches
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Manager) setTouch(touch Touch) {
+	for i := range m.touches {
+		if m.touches[i].ID == touch.ID {
+			m.touches[i] = touch
+			return
+		}
+	}
+	m.touches = append(m.touches, touch)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m *Manager) removeTouch(id int) {
+	for i := range m.touches {
+		if m.touches[i].ID == id {
+			m.touches = append(m.touches[:i], m.touches[i+1:]...)
+			return
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// buttonMask maps a mouse button to its bit, ignoring buttons that don't fit.
+func buttonMask(button int) uint32 {
+	if button < 0 || button >= 32 {
+		return 0
+	}
+	return 1 << uint(button)
+}
@@ -0,0 +1,109 @@
+package input
+
+import "testing"
+
+// ------------------------------------------------------------------------------------------------
+func TestKeyState(t *testing.T) {
+	m := NewManager()
+
+	m.HandleEvent(Event{Kind: EventKeyDown, Code: 'A'})
+	m.HandleEvent(Event{Kind: EventKeyDown, Code: 'A'})
+
+	if !m.IsKeyDown('A') || !m.IsKeyPressed('A') {
+		t.Error("Expected A to be down and pressed")
+	}
+
+	m.EndFrame()
+	m.HandleEvent(Event{Kind: EventKeyDown, Code: 'A'})
+
+	if m.IsKeyPressed('A') {
+		t.Error("Expected a repeated key down not to count as a new press")
+	}
+
+	m.HandleEvent(Event{Kind: EventKeyUp, Code: 'A'})
+
+	if m.IsKeyDown('A') || !m.IsKeyReleased('A') {
+		t.Error("Expected A to be up and released")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestMouseState(t *testing.T) {
+	m := NewManager()
+
+	m.HandleEvent(Event{Kind: EventMouseMove, X: 10, Y: 20})
+	m.HandleEvent(Event{Kind: EventMouseDown, Code: MouseRight, X: 11, Y: 21})
+	m.HandleEvent(Event{Kind: EventMouseWheel, DeltaY: 3})
+	m.HandleEvent(Event{Kind: EventMouseWheel, DeltaY: 2})
+
+	if x, y := m.MousePosition(); x != 11 || y != 21 {
+		t.Errorf("Expected mouse at (11, 21), got (%d, %d)", x, y)
+	}
+
+	if !m.IsMouseButtonDown(MouseRight) || m.IsMouseButtonDown(MouseLeft) {
+		t.Error("Expected only the right button to be down")
+	}
+
+	if _, dy := m.Wheel(); dy != 5 {
+		t.Errorf("Expected accumulated wheel movement of 5, got %f", dy)
+	}
+
+	m.EndFrame()
+	m.HandleEvent(Event{Kind: EventMouseUp, Code: MouseRight})
+
+	if m.IsMouseButtonDown(MouseRight) || m.IsMouseButtonPressed(MouseRight) {
+		t.Error("Expected the right button to be released")
+	}
+
+	if _, dy := m.Wheel(); dy != 0 {
+		t.Errorf("Expected the wheel to be reset, got %f", dy)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTouches(t *testing.T) {
+	m := NewManager()
+
+	m.HandleEvent(Event{Kind: EventTouchStart, Code: 1, X: 1, Y: 1})
+	m.HandleEvent(Event{Kind: EventTouchStart, Code: 2, X: 5, Y: 5})
+	m.HandleEvent(Event{Kind: EventTouchMove, Code: 1, X: 2, Y: 3})
+
+	touches := m.Touches()
+	if len(touches) != 2 || touches[0] != (Touch{ID: 1, X: 2, Y: 3}) {
+		t.Errorf("Unexpected touches %v", touches)
+	}
+
+	m.HandleEvent(Event{Kind: EventTouchEnd, Code: 1})
+
+	touches = m.Touches()
+	if len(touches) != 1 || touches[0].ID != 2 {
+		t.Errorf("Expected only touch 2 to remain, got %v", touches)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEventQueue(t *testing.T) {
+	m := NewManager()
+
+	if _, ok := m.PollEvent(); ok {
+		t.Error("Expected an empty queue")
+	}
+
+	for i := range MAX_QUEUED_EVENTS + 10 {
+		m.HandleEvent(Event{Kind: EventMouseMove, X: i})
+	}
+
+	e, ok := m.PollEvent()
+	if !ok || e.X != 10 {
+		t.Errorf("Expected the oldest events to be dropped, got %v", e)
+	}
+
+	events := m.Events()
+	if len(events) != MAX_QUEUED_EVENTS-1 || events[len(events)-1].X != MAX_QUEUED_EVENTS+9 {
+		t.Errorf("Unexpected drained events, got %d", len(events))
+	}
+
+	if len(m.Events()) != 0 {
+		t.Error("Expected the queue to be empty after draining")
+	}
+}
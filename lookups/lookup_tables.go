@@ -3,183 +3,175 @@
 package lookups
 
 // There are basic Get functions and then more advanced Interpolating Get functions for when
-// accuracy matters a bit more.
+// accuracy matters a bit more. Tables hold a power of two number of entries, so angles wrap
+// with a bitmask instead of math.Mod, and cosine reads the sine table a quarter turn on.
 
 import "math"
 
+//go:generate go run ../cmd/gogi-gentables -package lookups -out sine_table_gen.go -tables sin -size 4096 -prefix default
+
 // ------------------------------------------------------------------------------------------------
 const (
 	LOOKUP_TABLE_SIZE = 4096
+	MIN_TABLE_SIZE    = 4
 	MAX_ANGLE         = 2 * math.Pi
 )
 
 // ------------------------------------------------------------------------------------------------
-type LookupTables struct {
-	sineTable, cosineTable []float64
-}
+// Option changes how lookup tables are built.
+type Option func(*config)
 
 // ------------------------------------------------------------------------------------------------
-func NewLookupTables() *LookupTables {
-	return &LookupTables{
-		sineTable:   createSinLookupTable(LOOKUP_TABLE_SIZE),
-		cosineTable: createCosLookupTable(LOOKUP_TABLE_SIZE),
-	}
-
-	// for i := range LOOKUP_TABLE_SIZE {
-	// 	angle := float64(i) / LOOKUP_TABLE_SIZE * 2 * math.Pi // Map index to 0-2*Pi
-	// 	sineTable[i] = math.Sin(angle)
-
-	// 	angle = MAX_ANGLE * float64(i) / float64(LOOKUP_TABLE_SIZE-1)
-	// 	cosTable[i] = math.Cos(angle)
-	// }
+type config struct {
+	size      int
+	sineTable []float64
 }
 
 // ------------------------------------------------------------------------------------------------
-// Sin retrieves the sine value from the lookup table for a given angle.
-// This is a basic lookup without interpolation.
-func (l *LookupTables) Sin(angle float64) float64 {
-	return getSinFromLookup(angle, l.sineTable)
+// WithTableSize sets the number of entries per table. Sizes that are not a power of two are
+// rounded up to the next one, and anything below MIN_TABLE_SIZE is raised to it. It replaces
+// any table given to WithSineTable before it.
+func WithTableSize(size int) Option {
+	return func(c *config) {
+		c.size = nextPowerOfTwo(max(MIN_TABLE_SIZE, size))
+		c.sineTable = nil
+	}
 }
 
 // ------------------------------------------------------------------------------------------------
-// SinI retrieves the sine value from the lookup table for a given angle.
-// This function uses interpolation to provide a more accurate result.
-func (l *LookupTables) SinI(angle float64) float64 {
-	return getSinFromLookupInterpolated(angle, l.sineTable)
-}
+// WithSineTable uses a precomputed sine table, like one written by gogi-gentables, instead of
+// working the values out. Its length sets the table size and must be a power of two of at
+// least MIN_TABLE_SIZE. The float64 tables use it directly, without copying.
+func WithSineTable(table []float64) Option {
+	if len(table) < MIN_TABLE_SIZE || len(table)&(len(table)-1) != 0 {
+		panic("sine table size must be a power of two")
+	}
 
-// ------------------------------------------------------------------------------------------------
-func (l *LookupTables) Cos(angle float64) float64 {
-	return getCosFromLookup(angle, l.cosineTable)
+	return func(c *config) {
+		c.size, c.sineTable = len(table), table
+	}
 }
 
 // ------------------------------------------------------------------------------------------------
-// createSinLookupTable creates a sine lookup table for angles from 0 to 2*PI radians.
-// numEntries determines the resolution of the table.
-func createSinLookupTable(numEntries int) []float64 {
-	// Use a slice instead of an array for more flexibility,
-	// especially if the size is determined at runtime.
-	sinLookup := make([]float64, numEntries)
-
-	// Calculate the step size for the angle
-	// Each entry represents an angle increment.
-	angleStep := MAX_ANGLE / float64(numEntries)
+func newConfig(options []Option) config {
+	c := config{size: LOOKUP_TABLE_SIZE}
+	for _, option := range options {
+		option(&c)
+	}
 
-	// Populate the lookup table
-	for i := range numEntries {
-		// Calculate the actual angle for the current index
-		angle := float64(i) * angleStep
-		sinLookup[i] = math.Sin(angle)
+	// the default size is generated ahead of time, so it costs nothing at startup
+	if c.sineTable == nil && c.size == len(defaultSineTable) {
+		c.sineTable = defaultSineTable[:]
 	}
 
-	return sinLookup
+	// the lookups mask indexes with the size, so a shorter table would be read past its end
+	if c.sineTable != nil && len(c.sineTable) != c.size {
+		panic("sine table size does not match the table size")
+	}
+	return c
 }
 
 // ------------------------------------------------------------------------------------------------
-// getSinFromLookup retrieves the sine value from the lookup table for a given angle.
-// This is a basic lookup without interpolation.
-func getSinFromLookup(angle float64, lookupTable []float64) float64 {
-	numEntries := len(lookupTable)
-	// Normalize the angle to be within [0, 2*PI)
-	normalizedAngle := math.Mod(angle, MAX_ANGLE)
-	if normalizedAngle < 0 {
-		normalizedAngle += MAX_ANGLE
+// sineValues returns the sine table for the configuration, working it out if needed.
+func (c config) sineValues() []float64 {
+	if c.sineTable != nil {
+		return c.sineTable
 	}
+	return createSinLookupTable(c.size)
+}
 
-	// Map the angle to an index in the lookup table
-	// This scales the angle to the table's index range.
-	index := int(normalizedAngle / MAX_ANGLE * float64(numEntries))
-
-	// Ensure the index is within bounds (should be, due to normalization and scaling)
-	if index >= numEntries {
-		index = numEntries - 1 // Should ideally not happen if angle is precisely 2*PI etc.
+// ------------------------------------------------------------------------------------------------
+func nextPowerOfTwo(value int) int {
+	size := 1
+	for size < value {
+		size <<= 1
 	}
-
-	return lookupTable[index]
+	return size
 }
 
 // ------------------------------------------------------------------------------------------------
-// createCosLookupTable generates a lookup table for cosine values.
-// It takes the number of entries as input and returns a slice of float64.
-// The table covers a full 2*Pi cycle, with each entry representing
-// an increment in angle.
-func createCosLookupTable(numEntries int) []float64 {
-	// Initialize a slice to store the cosine values.
-	// Using a slice provides flexibility for dynamic sizing.
-	cosLookup := make([]float64, numEntries)
-
-	// Calculate the step size for the angle.
-	// This determines the angular increment between each entry in the table.
-	angleStep := MAX_ANGLE / float64(numEntries)
-
-	// Populate the lookup table with cosine values.
-	for i := range numEntries {
-		// Calculate the actual angle for the current index.
-		// The angle starts at 0 and goes up to (2*Pi - angleStep).
-		angle := float64(i) * angleStep
-		// Compute the cosine of the calculated angle and store it.
-		cosLookup[i] = math.Cos(angle)
-	}
+// LookupTables holds float64 values, the most accurate and the largest of the variants.
+type LookupTables struct {
+	sineTable  []float64
+	mask       int
+	quarter    int
+	radToIndex float64
 
-	return cosLookup
+	// the tables for the other functions are only built once they are used
+	atanTable, sqrtTable, invSqrtTable, expTable, logTable functionTable
 }
 
 // ------------------------------------------------------------------------------------------------
-// getSinFromLookupInterpolated retrieves the sine value from the lookup table for a given angle,
-// using linear interpolation for better accuracy.
-func getSinFromLookupInterpolated(angle float64, lookupTable []float64) float64 {
-	numEntries := len(lookupTable)
-	angleRange := MAX_ANGLE // The total range covered by the table
+func NewLookupTables(options ...Option) *LookupTables {
+	c := newConfig(options)
 
-	// Normalize the angle to be within [0, 2*PI)
-	normalizedAngle := math.Mod(angle, angleRange)
-	if normalizedAngle < 0 {
-		normalizedAngle += angleRange
+	return &LookupTables{
+		sineTable:  c.sineValues(),
+		mask:       c.size - 1,
+		quarter:    c.size / 4,
+		radToIndex: float64(c.size) / MAX_ANGLE,
 	}
+}
 
-	// Calculate the "floating point" index
-	// This gives us the precise position within the table, including fractions.
-	floatIndex := normalizedAngle / angleRange * float64(numEntries)
-
-	// Get the indices of the two surrounding table entries
-	idx1 := int(math.Floor(floatIndex))
-	idx2 := int(math.Ceil(floatIndex))
+// ------------------------------------------------------------------------------------------------
+// Size returns the number of entries in the table.
+func (l *LookupTables) Size() int {
+	return len(l.sineTable)
+}
 
-	// Handle edge cases for the last entry
-	if idx2 >= numEntries {
-		idx2 = 0 // Wrap around for angles near 2*PI
-	}
+// ------------------------------------------------------------------------------------------------
+// Sin retrieves the sine value from the lookup table for a given angle.
+// This is a basic lookup without interpolation. Positions between entries round towards
+// zero, as in the demo's original table.
+func (l *LookupTables) Sin(angle float64) float64 {
+	return l.sineTable[int(angle*l.radToIndex)&l.mask]
+}
 
-	// Get the values from the lookup table
-	val1 := lookupTable[idx1]
-	val2 := lookupTable[idx2]
+// ------------------------------------------------------------------------------------------------
+// SinI retrieves the sine value from the lookup table for a given angle.
+// This function uses interpolation to provide a more accurate result.
+func (l *LookupTables) SinI(angle float64) float64 {
+	index, fraction := splitIndex(angle * l.radToIndex)
+	val1 := l.sineTable[index&l.mask]
+	val2 := l.sineTable[(index+1)&l.mask]
+	return val1 + fraction*(val2-val1)
+}
 
-	// Calculate the fractional part (how far between idx1 and idx2 the angle is)
-	fraction := floatIndex - float64(idx1)
+// ------------------------------------------------------------------------------------------------
+func (l *LookupTables) Cos(angle float64) float64 {
+	return l.sineTable[(int(angle*l.radToIndex)+l.quarter)&l.mask]
+}
 
-	// Perform linear interpolation
+// ------------------------------------------------------------------------------------------------
+// CosI retrieves the cosine value for a given angle, interpolating between entries. With the
+// default table size the error is below 5e-7.
+func (l *LookupTables) CosI(angle float64) float64 {
+	index, fraction := splitIndex(angle * l.radToIndex)
+	val1 := l.sineTable[(index+l.quarter)&l.mask]
+	val2 := l.sineTable[(index+l.quarter+1)&l.mask]
 	return val1 + fraction*(val2-val1)
 }
 
 // ------------------------------------------------------------------------------------------------
-// getCosFromLookup retrieves the sine value from the lookup table for a given angle.
-// This is a basic lookup without interpolation.
-func getCosFromLookup(angle float64, lookupTable []float64) float64 {
-	numEntries := len(lookupTable)
-	// Normalize the angle to be within [0, 2*PI)
-	normalizedAngle := math.Mod(angle, MAX_ANGLE)
-	if normalizedAngle < 0 {
-		normalizedAngle += MAX_ANGLE
-	}
+// splitIndex splits a position in the table into the entry before it and how far it is
+// towards the next one.
+func splitIndex(position float64) (int, float64) {
+	index := math.Floor(position)
+	return int(index), position - index
+}
 
-	// Map the angle to an index in the lookup table
-	// This scales the angle to the table's index range.
-	index := int(normalizedAngle / MAX_ANGLE * float64(numEntries))
+// ------------------------------------------------------------------------------------------------
+// createSinLookupTable creates a sine lookup table for angles from 0 to 2*PI radians.
+// numEntries determines the resolution of the table.
+func createSinLookupTable(numEntries int) []float64 {
+	sinLookup := make([]float64, numEntries)
+
+	// Each entry represents an angle increment.
+	angleStep := MAX_ANGLE / float64(numEntries)
 
-	// Ensure the index is within bounds (should be, due to normalization and scaling)
-	if index >= numEntries {
-		index = numEntries - 1 // Should ideally not happen if angle is precisely 2*PI etc.
+	for i := range numEntries {
+		sinLookup[i] = math.Sin(float64(i) * angleStep)
 	}
 
-	return lookupTable[index]
+	return sinLookup
 }
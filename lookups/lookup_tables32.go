@@ -0,0 +1,62 @@
+package lookups
+
+// ------------------------------------------------------------------------------------------------
+// LookupTables32 holds float32 values, half the memory of LookupTables for effects that work
+// in float32 anyway.
+type LookupTables32 struct {
+	sineTable  []float32
+	mask       int
+	quarter    int
+	radToIndex float32
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewLookupTables32(options ...Option) *LookupTables32 {
+	c := newConfig(options)
+
+	table := make([]float32, c.size)
+	for i, value := range c.sineValues() {
+		table[i] = float32(value)
+	}
+
+	return &LookupTables32{
+		sineTable:  table,
+		mask:       c.size - 1,
+		quarter:    c.size / 4,
+		radToIndex: float32(float64(c.size) / MAX_ANGLE),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Size returns the number of entries in the table.
+func (l *LookupTables32) Size() int {
+	return len(l.sineTable)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *LookupTables32) Sin(angle float32) float32 {
+	return l.sineTable[int(angle*l.radToIndex)&l.mask]
+}
+
+// ------------------------------------------------------------------------------------------------
+// SinI interpolates between the two nearest entries.
+func (l *LookupTables32) SinI(angle float32) float32 {
+	index, fraction := splitIndex(float64(angle * l.radToIndex))
+	val1 := l.sineTable[index&l.mask]
+	val2 := l.sineTable[(index+1)&l.mask]
+	return val1 + float32(fraction)*(val2-val1)
+}
+
+// ------------------------------------------------------------------------------------------------
+// CosI interpolates between the two nearest entries.
+func (l *LookupTables32) CosI(angle float32) float32 {
+	index, fraction := splitIndex(float64(angle * l.radToIndex))
+	val1 := l.sineTable[(index+l.quarter)&l.mask]
+	val2 := l.sineTable[(index+l.quarter+1)&l.mask]
+	return val1 + float32(fraction)*(val2-val1)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *LookupTables32) Cos(angle float32) float32 {
+	return l.sineTable[(int(angle*l.radToIndex)+l.quarter)&l.mask]
+}
@@ -0,0 +1,105 @@
+package lookups
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+// Q16 is a Q16.16 fixed point number, with 16 bits for the whole part and 16 for the fraction.
+type Q16 int32
+
+// ------------------------------------------------------------------------------------------------
+const (
+	Q16_ONE = Q16(1 << 16)
+	// Q16_TWO_PI is a full turn, for stepping angles without floating point.
+	Q16_TWO_PI = Q16(411775)
+)
+
+// ------------------------------------------------------------------------------------------------
+func ToQ16(value float64) Q16 {
+	return Q16(math.Round(value * float64(Q16_ONE)))
+}
+
+// ------------------------------------------------------------------------------------------------
+func (q Q16) Float64() float64 {
+	return float64(q) / float64(Q16_ONE)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Mul multiplies two Q16 numbers.
+func (q Q16) Mul(other Q16) Q16 {
+	return Q16(int64(q) * int64(other) >> 16)
+}
+
+// ------------------------------------------------------------------------------------------------
+// LookupTablesQ16 works entirely in fixed point, taking angles in Q16 radians and returning
+// Q16 values, for integer only inner loops and targets where floating point is slow.
+type LookupTablesQ16 struct {
+	sineTable []Q16
+	mask      int
+	quarter   int
+	// radToIndex converts a Q16 angle to a table position with 16 fraction bits
+	radToIndex int64
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewLookupTablesQ16(options ...Option) *LookupTablesQ16 {
+	c := newConfig(options)
+
+	table := make([]Q16, c.size)
+	for i, value := range c.sineValues() {
+		table[i] = ToQ16(value)
+	}
+
+	return &LookupTablesQ16{
+		sineTable:  table,
+		mask:       c.size - 1,
+		quarter:    c.size / 4,
+		radToIndex: int64(math.Round(float64(c.size) / MAX_ANGLE * float64(Q16_ONE))),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Size returns the number of entries in the table.
+func (l *LookupTablesQ16) Size() int {
+	return len(l.sineTable)
+}
+
+// ------------------------------------------------------------------------------------------------
+// position returns the table position of an angle with 16 fraction bits. The arithmetic
+// shift rounds down, so negative angles wrap correctly with the mask.
+func (l *LookupTablesQ16) position(angle Q16) int64 {
+	return int64(angle) * l.radToIndex >> 16
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *LookupTablesQ16) Sin(angle Q16) Q16 {
+	return l.sineTable[int(l.position(angle)>>16)&l.mask]
+}
+
+// ------------------------------------------------------------------------------------------------
+// SinI interpolates between the two nearest entries.
+func (l *LookupTablesQ16) SinI(angle Q16) Q16 {
+	position := l.position(angle)
+	index := int(position >> 16)
+	fraction := Q16(position & 0xffff)
+
+	val1 := l.sineTable[index&l.mask]
+	val2 := l.sineTable[(index+1)&l.mask]
+	return val1 + (val2 - val1).Mul(fraction)
+}
+
+// ------------------------------------------------------------------------------------------------
+// CosI interpolates between the two nearest entries.
+func (l *LookupTablesQ16) CosI(angle Q16) Q16 {
+	position := l.position(angle)
+	index := int(position>>16) + l.quarter
+	fraction := Q16(position & 0xffff)
+
+	val1 := l.sineTable[index&l.mask]
+	val2 := l.sineTable[(index+1)&l.mask]
+	return val1 + (val2 - val1).Mul(fraction)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *LookupTablesQ16) Cos(angle Q16) Q16 {
+	return l.sineTable[(int(l.position(angle)>>16)+l.quarter)&l.mask]
+}
@@ -0,0 +1,160 @@
+package lookups
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+// maxError samples a function over a few turns in both directions and returns the largest
+// difference from the expected function.
+func maxError(got, expected func(float64) float64) float64 {
+	worst := 0.0
+	for i := -20000; i <= 20000; i++ {
+		angle := float64(i) * 0.00173
+		worst = max(worst, math.Abs(got(angle)-expected(angle)))
+	}
+	return worst
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestWithTableSize(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Option
+		expected int
+	}{
+		{name: "default", expected: LOOKUP_TABLE_SIZE},
+		{name: "power of two", options: []Option{WithTableSize(16384)}, expected: 16384},
+		{name: "rounded up", options: []Option{WithTableSize(1000)}, expected: 1024},
+		{name: "minimum", options: []Option{WithTableSize(1)}, expected: MIN_TABLE_SIZE},
+		{name: "replaces a custom table", options: []Option{WithSineTable(make([]float64, 8)), WithTableSize(64)}, expected: 64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sizes := []int{
+				NewLookupTables(tt.options...).Size(),
+				NewLookupTables32(tt.options...).Size(),
+				NewLookupTablesQ16(tt.options...).Size(),
+			}
+
+			for _, size := range sizes {
+				if size != tt.expected {
+					t.Errorf("Expected %d entries, but got %d", tt.expected, size)
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestGeneratedSineTable(t *testing.T) {
+	// the generated default table must match what createSinLookupTable works out
+	expected := createSinLookupTable(LOOKUP_TABLE_SIZE)
+	for i, value := range defaultSineTable {
+		if value != expected[i] {
+			t.Fatalf("Expected entry %d to be %v, but got %v", i, expected[i], value)
+		}
+	}
+
+	table := []float64{0, 1, 0, -1}
+	lt := NewLookupTables(WithSineTable(table))
+	if lt.Size() != 4 || lt.Sin(math.Pi/2) != 1 || lt.Cos(0) != 1 {
+		t.Errorf("Expected a lookup table using the supplied values, but got size %d", lt.Size())
+	}
+
+	q16 := NewLookupTablesQ16(WithSineTable(table))
+	if q16.Size() != 4 || q16.Sin(Q16_TWO_PI/4) != Q16_ONE {
+		t.Errorf("Expected a Q16 table using the supplied values, but got %v", q16.Sin(Q16_TWO_PI/4))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for a table size that is not a power of two")
+		}
+	}()
+	WithSineTable(make([]float64, 6))
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestLookupVariantsAccuracy(t *testing.T) {
+	f64 := NewLookupTables()
+	f32 := NewLookupTables32()
+	q16 := NewLookupTablesQ16()
+
+	// one table step is 2*Pi/4096, about 0.0015, which bounds the error without interpolation
+	tests := []struct {
+		name     string
+		got      func(float64) float64
+		expected func(float64) float64
+		maxError float64
+	}{
+		{name: "float64 Sin", got: f64.Sin, expected: math.Sin, maxError: 0.0016},
+		{name: "float64 SinI", got: f64.SinI, expected: math.Sin, maxError: 0.000001},
+		{name: "float64 Cos", got: f64.Cos, expected: math.Cos, maxError: 0.0016},
+		{
+			name:     "float32 Sin",
+			got:      func(a float64) float64 { return float64(f32.Sin(float32(a))) },
+			expected: math.Sin, maxError: 0.0016,
+		},
+		{
+			name:     "float32 SinI",
+			got:      func(a float64) float64 { return float64(f32.SinI(float32(a))) },
+			expected: math.Sin, maxError: 0.00001,
+		},
+		{
+			name:     "float32 Cos",
+			got:      func(a float64) float64 { return float64(f32.Cos(float32(a))) },
+			expected: math.Cos, maxError: 0.0016,
+		},
+		{
+			name:     "Q16 Sin",
+			got:      func(a float64) float64 { return q16.Sin(ToQ16(a)).Float64() },
+			expected: math.Sin, maxError: 0.0016,
+		},
+		{
+			name:     "Q16 SinI",
+			got:      func(a float64) float64 { return q16.SinI(ToQ16(a)).Float64() },
+			expected: math.Sin, maxError: 0.0001,
+		},
+		{
+			name:     "float32 CosI",
+			got:      func(a float64) float64 { return float64(f32.CosI(float32(a))) },
+			expected: math.Cos, maxError: 0.00001,
+		},
+		{
+			name:     "Q16 CosI",
+			got:      func(a float64) float64 { return q16.CosI(ToQ16(a)).Float64() },
+			expected: math.Cos, maxError: 0.0001,
+		},
+		{
+			name:     "Q16 Cos",
+			got:      func(a float64) float64 { return q16.Cos(ToQ16(a)).Float64() },
+			expected: math.Cos, maxError: 0.0016,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if worst := maxError(tt.got, tt.expected); worst > tt.maxError {
+				t.Errorf("Expected a maximum error of %g, but got %g", tt.maxError, worst)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestQ16(t *testing.T) {
+	if ToQ16(1.5) != Q16_ONE+Q16_ONE/2 {
+		t.Errorf("Expected 1.5 to be %d, but got %d", Q16_ONE+Q16_ONE/2, ToQ16(1.5))
+	}
+
+	if got := ToQ16(-2.5).Mul(ToQ16(1.5)).Float64(); got != -3.75 {
+		t.Errorf("Expected -3.75, but got %f", got)
+	}
+
+	if got := Q16_TWO_PI.Float64(); math.Abs(got-2*math.Pi) > 0.00001 {
+		t.Errorf("Expected a full turn, but got %f", got)
+	}
+}
@@ -0,0 +1,158 @@
+package lookups
+
+import (
+	"math"
+	"sync"
+)
+
+// The functions below reduce their argument to a small range, look it up in an interpolated
+// table and scale the result back. The error bounds in the comments were measured by the
+// tests with the default table size, and shrink by about four times for each doubling of it.
+// Special values like zero, negative numbers, infinities and NaN are left to the math package.
+
+// ------------------------------------------------------------------------------------------------
+// functionTable samples a function evenly over a range, built the first time it is needed.
+type functionTable struct {
+	once   sync.Once
+	values []float64
+	start  float64
+	scale  float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// prepare builds the table with size steps from start to end, including end so the last step
+// can be interpolated. It is safe to call from several goroutines.
+func (t *functionTable) prepare(size int, start, end float64, f func(float64) float64) *functionTable {
+	t.once.Do(func() {
+		t.values = make([]float64, size+1)
+		t.start = start
+		t.scale = float64(size) / (end - start)
+
+		step := (end - start) / float64(size)
+		for i := range t.values {
+			t.values[i] = f(start + float64(i)*step)
+		}
+	})
+	return t
+}
+
+// ------------------------------------------------------------------------------------------------
+// at interpolates the table at x, which must be inside its range.
+func (t *functionTable) at(x float64) float64 {
+	position := (x - t.start) * t.scale
+	index := min(int(position), len(t.values)-2)
+	fraction := position - float64(index)
+	return t.values[index] + fraction*(t.values[index+1]-t.values[index])
+}
+
+// ------------------------------------------------------------------------------------------------
+// Tan returns the tangent of an angle, from the interpolated sine and cosine. The absolute
+// error stays below 2e-8 while |tan| is under 10 and grows towards the poles.
+func (l *LookupTables) Tan(angle float64) float64 {
+	return l.SinI(angle) / l.CosI(angle)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Atan2 returns the angle of the point x,y in radians, between -Pi and Pi, with an absolute
+// error below 1e-8.
+func (l *LookupTables) Atan2(y, x float64) float64 {
+	if x == 0 && y == 0 || math.IsNaN(x) || math.IsNaN(y) || math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return math.Atan2(y, x)
+	}
+
+	table := l.atanTable.prepare(len(l.sineTable), 0, 1, math.Atan)
+
+	// fold into the first octant, where the ratio is between 0 and 1
+	absX, absY := math.Abs(x), math.Abs(y)
+	var angle float64
+	if absY <= absX {
+		angle = table.at(absY / absX)
+	} else {
+		angle = math.Pi/2 - table.at(absX/absY)
+	}
+
+	if x < 0 {
+		angle = math.Pi - angle
+	}
+	if y < 0 || y == 0 && math.Signbit(y) {
+		angle = -angle
+	}
+	return angle
+}
+
+// ------------------------------------------------------------------------------------------------
+// reduceRoot splits a positive number into m and e with value = m * 2^e, m between 1 and 4
+// and e even, so square roots can be taken of both halves separately.
+func reduceRoot(value float64) (float64, int) {
+	fraction, exponent := math.Frexp(value)
+	m, e := fraction*2, exponent-1
+	if e%2 != 0 {
+		m, e = m*2, e-1
+	}
+	return m, e
+}
+
+// ------------------------------------------------------------------------------------------------
+// Sqrt returns the square root with a relative error below 3e-8.
+func (l *LookupTables) Sqrt(value float64) float64 {
+	if value <= 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return math.Sqrt(value)
+	}
+
+	m, e := reduceRoot(value)
+	return math.Ldexp(l.sqrtTable.prepare(len(l.sineTable), 1, 4, math.Sqrt).at(m), e/2)
+}
+
+// ------------------------------------------------------------------------------------------------
+// InvSqrt returns 1 divided by the square root, with a relative error below 1e-7.
+func (l *LookupTables) InvSqrt(value float64) float64 {
+	if value <= 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 1 / math.Sqrt(value)
+	}
+
+	invSqrt := func(x float64) float64 { return 1 / math.Sqrt(x) }
+
+	m, e := reduceRoot(value)
+	return math.Ldexp(l.invSqrtTable.prepare(len(l.sineTable), 1, 4, invSqrt).at(m), -e/2)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Exp returns e to the power of value, with a relative error below 5e-9.
+func (l *LookupTables) Exp(value float64) float64 {
+	// outside this range the result overflows or underflows anyway
+	if !(value > -700 && value < 700) {
+		return math.Exp(value)
+	}
+
+	// value = k*ln(2) + r, so e^value = 2^k * e^r with r between 0 and ln(2)
+	k := math.Floor(value / math.Ln2)
+	r := value - k*math.Ln2
+
+	return math.Ldexp(l.expTable.prepare(len(l.sineTable), 0, math.Ln2, math.Exp).at(r), int(k))
+}
+
+// ------------------------------------------------------------------------------------------------
+// Log returns the natural logarithm, with an absolute error below 1e-8.
+func (l *LookupTables) Log(value float64) float64 {
+	if value <= 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return math.Log(value)
+	}
+
+	// value = m * 2^e with m between 1 and 2, so log(value) = log(m) + e*ln(2)
+	fraction, exponent := math.Frexp(value)
+	m, e := fraction*2, exponent-1
+
+	return l.logTable.prepare(len(l.sineTable), 1, 2, math.Log).at(m) + float64(e)*math.Ln2
+}
+
+// ------------------------------------------------------------------------------------------------
+// Pow returns base to the power of exponent as Exp(exponent * Log(base)), so the relative
+// error grows with the size of that product and stays below 1e-7 while it is under 20.
+// Zero or negative bases and special values are left to math.Pow.
+func (l *LookupTables) Pow(base, exponent float64) float64 {
+	if base <= 0 || math.IsInf(base, 0) || math.IsNaN(base) || math.IsInf(exponent, 0) || math.IsNaN(exponent) {
+		return math.Pow(base, exponent)
+	}
+
+	return l.Exp(exponent * l.Log(base))
+}
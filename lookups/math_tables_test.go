@@ -0,0 +1,169 @@
+package lookups
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+// sample calls check with count values spread evenly from start to end.
+func sample(start, end float64, count int, check func(x float64)) {
+	for i := range count + 1 {
+		check(start + (end-start)*float64(i)/float64(count))
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// These tests measure the error of every function against the math package with the default
+// table size. The bounds are the ones promised in the doc comments.
+func TestMathTablesMaxError(t *testing.T) {
+	l := NewLookupTables()
+
+	tests := []struct {
+		name     string
+		measure  func() float64
+		maxError float64
+	}{
+		{
+			name: "CosI",
+			measure: func() float64 {
+				return maxError(l.CosI, math.Cos)
+			},
+			maxError: 5e-7,
+		},
+		{
+			name: "Tan",
+			measure: func() float64 {
+				worst := 0.0
+				sample(-20, 20, 100000, func(a float64) {
+					if expected := math.Tan(a); math.Abs(expected) < 10 {
+						worst = max(worst, math.Abs(l.Tan(a)-expected))
+					}
+				})
+				return worst
+			},
+			maxError: 2e-8,
+		},
+		{
+			name: "Atan2",
+			measure: func() float64 {
+				worst := 0.0
+				sample(0, 2*math.Pi, 100000, func(a float64) {
+					for _, radius := range []float64{0.001, 1, 12345} {
+						y, x := math.Sin(a)*radius, math.Cos(a)*radius
+						worst = max(worst, math.Abs(l.Atan2(y, x)-math.Atan2(y, x)))
+					}
+				})
+				return worst
+			},
+			maxError: 1e-8,
+		},
+		{
+			name: "Sqrt",
+			measure: func() float64 {
+				worst := 0.0
+				sample(-30, 30, 100000, func(e float64) {
+					x := math.Exp(e)
+					worst = max(worst, math.Abs(l.Sqrt(x)-math.Sqrt(x))/math.Sqrt(x))
+				})
+				return worst
+			},
+			maxError: 3e-8,
+		},
+		{
+			name: "InvSqrt",
+			measure: func() float64 {
+				worst := 0.0
+				sample(-30, 30, 100000, func(e float64) {
+					x, expected := math.Exp(e), 1/math.Sqrt(math.Exp(e))
+					worst = max(worst, math.Abs(l.InvSqrt(x)-expected)/expected)
+				})
+				return worst
+			},
+			maxError: 1e-7,
+		},
+		{
+			name: "Exp",
+			measure: func() float64 {
+				worst := 0.0
+				sample(-50, 50, 100000, func(x float64) {
+					worst = max(worst, math.Abs(l.Exp(x)-math.Exp(x))/math.Exp(x))
+				})
+				return worst
+			},
+			maxError: 5e-9,
+		},
+		{
+			name: "Log",
+			measure: func() float64 {
+				worst := 0.0
+				sample(-30, 30, 100000, func(e float64) {
+					x := math.Exp(e)
+					worst = max(worst, math.Abs(l.Log(x)-math.Log(x)))
+				})
+				return worst
+			},
+			maxError: 1e-8,
+		},
+		{
+			name: "Pow",
+			measure: func() float64 {
+				worst := 0.0
+				sample(0.01, 10, 1000, func(base float64) {
+					sample(-8, 8, 100, func(exponent float64) {
+						if expected := math.Pow(base, exponent); math.Abs(exponent*math.Log(base)) < 20 {
+							worst = max(worst, math.Abs(l.Pow(base, exponent)-expected)/expected)
+						}
+					})
+				})
+				return worst
+			},
+			maxError: 1e-7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worst := tt.measure()
+			t.Logf("maximum error %.3g", worst)
+
+			if worst > tt.maxError {
+				t.Errorf("Expected a maximum error of %g, but got %g", tt.maxError, worst)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestMathTablesSpecialValues(t *testing.T) {
+	l := NewLookupTables()
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{name: "Sqrt of zero", got: l.Sqrt(0), expected: 0},
+		{name: "Sqrt of infinity", got: l.Sqrt(math.Inf(1)), expected: math.Inf(1)},
+		{name: "InvSqrt of zero", got: l.InvSqrt(0), expected: math.Inf(1)},
+		{name: "Exp overflow", got: l.Exp(1000), expected: math.Inf(1)},
+		{name: "Exp underflow", got: l.Exp(-1000), expected: 0},
+		{name: "Log of zero", got: l.Log(0), expected: math.Inf(-1)},
+		{name: "Pow of negative base", got: l.Pow(-2, 3), expected: -8},
+		{name: "Pow of zero", got: l.Pow(0, 2), expected: 0},
+		{name: "Atan2 pointing left", got: l.Atan2(0, -1), expected: math.Pi},
+		{name: "Atan2 straight down", got: l.Atan2(-1, 0), expected: -math.Pi / 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Expected %g, but got %g", tt.expected, tt.got)
+			}
+		})
+	}
+
+	if !math.IsNaN(l.Sqrt(-1)) || !math.IsNaN(l.Log(-1)) {
+		t.Error("Expected NaN for the square root and logarithm of a negative number")
+	}
+}
@@ -0,0 +1,145 @@
+package noise
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+const (
+	DEFAULT_OCTAVES    = 5
+	DEFAULT_LACUNARITY = 2.0
+	DEFAULT_GAIN       = 0.5
+)
+
+// ------------------------------------------------------------------------------------------------
+// layering is how Fractal combines its octaves.
+type layering int
+
+// ------------------------------------------------------------------------------------------------
+const (
+	layerFBm layering = iota
+	layerTurbulence
+	layerRidged
+)
+
+// ------------------------------------------------------------------------------------------------
+// Fractal layers octaves of a noise, each at a higher frequency and lower amplitude than
+// the one before, adding detail at every scale like clouds, terrain or marble.
+type Fractal struct {
+	Noise Noise
+
+	Octaves int
+	// Lacunarity multiplies the frequency from one octave to the next.
+	Lacunarity float64
+	// Gain multiplies the amplitude from one octave to the next.
+	Gain float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewFractal layers five octaves of the noise, each twice the frequency and half the
+// amplitude of the one before.
+func NewFractal(noise Noise) *Fractal {
+	return &Fractal{
+		Noise:      noise,
+		Octaves:    DEFAULT_OCTAVES,
+		Lacunarity: DEFAULT_LACUNARITY,
+		Gain:       DEFAULT_GAIN,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// FBm1D is fractal Brownian motion, the plain sum of the octaves, from -1 to 1.
+func (f *Fractal) FBm1D(x float64) float64 {
+	return f.sum(layerFBm, func(frequency float64) float64 {
+		return f.Noise.Noise1D(x * frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fractal) FBm2D(x, y float64) float64 {
+	return f.sum(layerFBm, func(frequency float64) float64 {
+		return f.Noise.Noise2D(x*frequency, y*frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fractal) FBm3D(x, y, z float64) float64 {
+	return f.sum(layerFBm, func(frequency float64) float64 {
+		return f.Noise.Noise3D(x*frequency, y*frequency, z*frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+// Turbulence1D sums the absolute value of the octaves, from 0 to 1. The creases where the
+// noise crosses zero look like flames or billowing smoke.
+func (f *Fractal) Turbulence1D(x float64) float64 {
+	return f.sum(layerTurbulence, func(frequency float64) float64 {
+		return f.Noise.Noise1D(x * frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fractal) Turbulence2D(x, y float64) float64 {
+	return f.sum(layerTurbulence, func(frequency float64) float64 {
+		return f.Noise.Noise2D(x*frequency, y*frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fractal) Turbulence3D(x, y, z float64) float64 {
+	return f.sum(layerTurbulence, func(frequency float64) float64 {
+		return f.Noise.Noise3D(x*frequency, y*frequency, z*frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+// Ridged1D turns the creases of turbulence into sharp ridges, from 0 to 1, like mountain
+// ranges. Each octave is weighted by the one before, so detail gathers on the ridges.
+func (f *Fractal) Ridged1D(x float64) float64 {
+	return f.sum(layerRidged, func(frequency float64) float64 {
+		return f.Noise.Noise1D(x * frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fractal) Ridged2D(x, y float64) float64 {
+	return f.sum(layerRidged, func(frequency float64) float64 {
+		return f.Noise.Noise2D(x*frequency, y*frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (f *Fractal) Ridged3D(x, y, z float64) float64 {
+	return f.sum(layerRidged, func(frequency float64) float64 {
+		return f.Noise.Noise3D(x*frequency, y*frequency, z*frequency)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+// sum adds up the octaves, dividing by the total amplitude so the range doesn't depend on
+// the number of octaves.
+func (f *Fractal) sum(layering layering, octave func(frequency float64) float64) float64 {
+	frequency, amplitude := 1.0, 1.0
+	total, weight, ridgeWeight := 0.0, 0.0, 1.0
+
+	for range max(1, f.Octaves) {
+		value := octave(frequency)
+
+		switch layering {
+		case layerTurbulence:
+			value = math.Abs(value)
+		case layerRidged:
+			value = 1 - math.Abs(value)
+			value *= value * ridgeWeight
+			ridgeWeight = min(1, value*2)
+		}
+
+		total += value * amplitude
+		weight += amplitude
+		frequency *= f.Lacunarity
+		amplitude *= f.Gain
+	}
+
+	if weight == 0 {
+		return 0
+	}
+	return total / weight
+}
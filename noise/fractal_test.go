@@ -0,0 +1,85 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestFractalRanges(t *testing.T) {
+	f := NewFractal(NewPerlin(4))
+
+	tests := []struct {
+		name      string
+		sample    func(x, y, z float64) []float64
+		low, high float64
+	}{
+		{
+			name:   "fbm",
+			sample: func(x, y, z float64) []float64 { return []float64{f.FBm1D(x), f.FBm2D(x, y), f.FBm3D(x, y, z)} },
+			low:    -1, high: 1,
+		},
+		{
+			name: "turbulence",
+			sample: func(x, y, z float64) []float64 {
+				return []float64{f.Turbulence1D(x), f.Turbulence2D(x, y), f.Turbulence3D(x, y, z)}
+			},
+			low: 0, high: 1,
+		},
+		{
+			name: "ridged",
+			sample: func(x, y, z float64) []float64 {
+				return []float64{f.Ridged1D(x), f.Ridged2D(x, y), f.Ridged3D(x, y, z)}
+			},
+			low: 0, high: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples(func(x, y, z float64) {
+				for _, value := range tt.sample(x, y, z) {
+					if value < tt.low || value > tt.high {
+						t.Fatalf("Expected values from %f to %f, but got %f", tt.low, tt.high, value)
+					}
+				}
+			})
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFractalOneOctave(t *testing.T) {
+	n := NewOpenSimplex(9)
+	f := NewFractal(n)
+	f.Octaves = 1
+
+	samples(func(x, y, z float64) {
+		if f.FBm2D(x, y) != n.Noise2D(x, y) {
+			t.Fatal("Expected a single octave of fBm to be the noise itself")
+		}
+		if f.Turbulence3D(x, y, z) != math.Abs(n.Noise3D(x, y, z)) {
+			t.Fatal("Expected a single octave of turbulence to be the absolute noise")
+		}
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFractalAddsDetail(t *testing.T) {
+	// more octaves give bigger differences between close samples
+	roughness := func(octaves int) float64 {
+		f := NewFractal(NewValue(6))
+		f.Octaves = octaves
+
+		total := 0.0
+		for i := range 1000 {
+			x := float64(i) * 0.01
+			total += math.Abs(f.FBm1D(x+0.01) - f.FBm1D(x))
+		}
+		return total
+	}
+
+	if smooth, rough := roughness(1), roughness(6); rough <= smooth {
+		t.Errorf("Expected 6 octaves to be rougher than 1, but got %f and %f", rough, smooth)
+	}
+}
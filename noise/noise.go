@@ -0,0 +1,75 @@
+// Package noise generates coherent noise for procedural textures and organic motion: Perlin,
+// OpenSimplex, value and Worley (cellular) noise in one, two and three dimensions. Nearby
+// inputs give nearby outputs, unlike the uniform values from randomness. Every generator is
+// seeded, so the same seed always gives the same pattern. Fractal layers several octaves
+// into fBm, turbulence or ridged noise, and Render draws any of them through a palette.
+package noise
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Noise is coherent noise in one, two or three dimensions, returning values from -1 to 1.
+// The lattice has a spacing of 1, so scale the inputs down for smoother patterns.
+type Noise interface {
+	Noise1D(x float64) float64
+	Noise2D(x, y float64) float64
+	Noise3D(x, y, z float64) float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// permutation is a shuffled table of 0 to 255, repeated so lookups of a lookup never need
+// wrapping. It hashes lattice points to pseudo random values.
+type permutation [512]uint8
+
+// ------------------------------------------------------------------------------------------------
+// Seed shuffles the table, giving a new pattern for every seed.
+func (p *permutation) Seed(seed uint64) {
+	source := randomness.NewSource(seed)
+
+	for i := range 256 {
+		p[i] = uint8(i)
+	}
+	for i := 255; i > 0; i-- {
+		j := source.IntN(i + 1)
+		p[i], p[j] = p[j], p[i]
+	}
+	copy(p[256:], p[:256])
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *permutation) hash1(x int) uint8 {
+	return p[x&255]
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *permutation) hash2(x, y int) uint8 {
+	return p[int(p[x&255])+y&255]
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *permutation) hash3(x, y, z int) uint8 {
+	return p[int(p[int(p[x&255])+y&255])+z&255]
+}
+
+// ------------------------------------------------------------------------------------------------
+// fade is Ken Perlin's 6t^5 - 15t^4 + 10t^3 curve, which has no sudden changes in slope or
+// curvature at the lattice points.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// ------------------------------------------------------------------------------------------------
+func lerp(a, b, t float64) float64 {
+	return a + t*(b-a)
+}
+
+// ------------------------------------------------------------------------------------------------
+// floor returns the lattice cell x is in and how far into the cell it is.
+func floor(x float64) (int, float64) {
+	f := math.Floor(x)
+	return int(f), x - f
+}
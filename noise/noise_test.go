@@ -0,0 +1,175 @@
+package noise
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+// noises returns every generator with the same seed.
+func noises(seed uint64) []struct {
+	name  string
+	noise Noise
+} {
+	return []struct {
+		name  string
+		noise Noise
+	}{
+		{name: "perlin", noise: NewPerlin(seed)},
+		{name: "opensimplex", noise: NewOpenSimplex(seed)},
+		{name: "value", noise: NewValue(seed)},
+		{name: "worley", noise: NewWorley(seed)},
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// samples calls f with points spread over a few hundred lattice cells, negative ones too.
+func samples(f func(x, y, z float64)) {
+	for i := range 20000 {
+		x := float64(i%173)*0.377 - 30
+		y := float64(i/173)*0.411 - 20
+		z := float64(i%59)*0.293 - 8
+		f(x, y, z)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestNoiseRange(t *testing.T) {
+	for _, tt := range noises(1) {
+		t.Run(tt.name, func(t *testing.T) {
+			low, high := math.Inf(1), math.Inf(-1)
+
+			samples(func(x, y, z float64) {
+				for _, value := range []float64{tt.noise.Noise1D(x), tt.noise.Noise2D(x, y), tt.noise.Noise3D(x, y, z)} {
+					low, high = min(low, value), max(high, value)
+				}
+			})
+
+			if low < -1 || high > 1 {
+				t.Errorf("Expected values from -1 to 1, but got %f to %f", low, high)
+			}
+
+			// all the generators should use a good part of the range
+			if high-low < 0.8 {
+				t.Errorf("Expected values to spread out, but got %f to %f", low, high)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestNoiseIsSeeded(t *testing.T) {
+	first, second, other := noises(7), noises(7), noises(8)
+
+	for i, tt := range first {
+		t.Run(tt.name, func(t *testing.T) {
+			same, different := true, false
+
+			samples(func(x, y, z float64) {
+				value := tt.noise.Noise3D(x, y, z)
+				same = same && value == second[i].noise.Noise3D(x, y, z)
+				different = different || value != other[i].noise.Noise3D(x, y, z)
+			})
+
+			if !same {
+				t.Error("Expected the same seed to give the same noise")
+			}
+			if !different {
+				t.Error("Expected a different seed to give different noise")
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestNoiseIsContinuous(t *testing.T) {
+	const step = 0.001
+
+	for _, tt := range noises(3) {
+		t.Run(tt.name, func(t *testing.T) {
+			worst := 0.0
+
+			samples(func(x, y, z float64) {
+				worst = max(worst,
+					math.Abs(tt.noise.Noise1D(x+step)-tt.noise.Noise1D(x)),
+					math.Abs(tt.noise.Noise2D(x+step, y+step)-tt.noise.Noise2D(x, y)),
+					math.Abs(tt.noise.Noise3D(x+step, y, z+step)-tt.noise.Noise3D(x, y, z)),
+				)
+			})
+
+			// coherent noise never jumps, so tiny moves give tiny changes
+			if worst > 0.05 {
+				t.Errorf("Expected small changes for small steps, but got a change of %f", worst)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestOpenSimplexHasNoSeams(t *testing.T) {
+	const step = 1e-7
+	n := NewOpenSimplex(1)
+
+	// a line through a lot of lattice cells, x near 187.008 crossed a seam once
+	worst := 0.0
+	for i := range 200000 {
+		x := 180 + float64(i)*0.0001
+		y, z := 0.37*x+0.3, 0.71*x+0.7
+		worst = max(worst, math.Abs(n.Noise3D(x+step, 0.37*(x+step)+0.3, 0.71*(x+step)+0.7)-n.Noise3D(x, y, z)))
+	}
+
+	// without jumps, a step this small can only change the value by a tiny amount
+	if worst > 1e-4 {
+		t.Errorf("Expected no jumps between nearby points, but got a change of %g", worst)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPerlinIsZeroOnTheLattice(t *testing.T) {
+	n := NewPerlin(5)
+
+	for i := -3; i <= 3; i++ {
+		v := float64(i)
+		if n.Noise1D(v) != 0 || n.Noise2D(v, -v) != 0 || n.Noise3D(v, 2, -v) != 0 {
+			t.Errorf("Expected zero at lattice point %d", i)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestWorleyDistances(t *testing.T) {
+	tests := []struct {
+		name     string
+		distance Distance
+	}{
+		{name: "euclidean", distance: DistanceEuclidean},
+		{name: "manhattan", distance: DistanceManhattan},
+		{name: "chebyshev", distance: DistanceChebyshev},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NewWorley(2)
+			n.Distance = tt.distance
+
+			samples(func(x, y, z float64) {
+				f1, f2 := n.Distances2D(x, y)
+				if f1 < 0 || f2 < f1 {
+					t.Fatalf("Expected 0 <= f1 <= f2, but got %f and %f", f1, f2)
+				}
+
+				f1, f2 = n.Distances3D(x, y, z)
+				if f1 < 0 || f2 < f1 {
+					t.Fatalf("Expected 0 <= f1 <= f2, but got %f and %f", f1, f2)
+				}
+			})
+		})
+	}
+
+	// a feature point is at zero distance from itself
+	n := NewWorley(2)
+	x := n.offset(n.hash1(4), 0) + 4
+	if f1, _ := n.Distances1D(x); f1 != 0 {
+		t.Errorf("Expected no distance at a feature point, but got %f", f1)
+	}
+}
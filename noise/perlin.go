@@ -0,0 +1,111 @@
+package noise
+
+// ------------------------------------------------------------------------------------------------
+// Perlin is Ken Perlin's improved gradient noise. It is zero at every lattice point, with
+// random slopes in between.
+type Perlin struct {
+	permutation
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewPerlin(seed uint64) *Perlin {
+	n := &Perlin{}
+	n.Seed(seed)
+	return n
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Perlin) Noise1D(x float64) float64 {
+	xi, xf := floor(x)
+	u := fade(xf)
+
+	// the slopes go up to 8 either way, which scales the result by up to 4
+	return lerp(grad1(n.hash1(xi), xf), grad1(n.hash1(xi+1), xf-1), u) * 0.25
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Perlin) Noise2D(x, y float64) float64 {
+	xi, xf := floor(x)
+	yi, yf := floor(y)
+	u, v := fade(xf), fade(yf)
+
+	bottom := lerp(grad2(n.hash2(xi, yi), xf, yf), grad2(n.hash2(xi+1, yi), xf-1, yf), u)
+	top := lerp(grad2(n.hash2(xi, yi+1), xf, yf-1), grad2(n.hash2(xi+1, yi+1), xf-1, yf-1), u)
+	return lerp(bottom, top, v)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Perlin) Noise3D(x, y, z float64) float64 {
+	xi, xf := floor(x)
+	yi, yf := floor(y)
+	zi, zf := floor(z)
+	u, v, w := fade(xf), fade(yf), fade(zf)
+
+	corner := func(dx, dy, dz int) float64 {
+		return grad3(n.hash3(xi+dx, yi+dy, zi+dz), xf-float64(dx), yf-float64(dy), zf-float64(dz))
+	}
+
+	near := lerp(lerp(corner(0, 0, 0), corner(1, 0, 0), u), lerp(corner(0, 1, 0), corner(1, 1, 0), u), v)
+	far := lerp(lerp(corner(0, 0, 1), corner(1, 0, 1), u), lerp(corner(0, 1, 1), corner(1, 1, 1), u), v)
+	return lerp(near, far, w)
+}
+
+// ------------------------------------------------------------------------------------------------
+// grad1 is the distance from a lattice point times a slope from -8 to 8.
+func grad1(hash uint8, x float64) float64 {
+	slope := float64(1 + hash&7)
+	if hash&8 != 0 {
+		slope = -slope
+	}
+	return slope * x
+}
+
+// ------------------------------------------------------------------------------------------------
+// grad2 picks one of eight gradients, along the axes and the diagonals.
+func grad2(hash uint8, x, y float64) float64 {
+	switch hash & 7 {
+	case 0:
+		return x + y
+	case 1:
+		return -x + y
+	case 2:
+		return x - y
+	case 3:
+		return -x - y
+	case 4:
+		return x
+	case 5:
+		return -x
+	case 6:
+		return y
+	default:
+		return -y
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// grad3 picks one of the twelve cube edge gradients from the improved noise paper, with four
+// repeated to make sixteen.
+func grad3(hash uint8, x, y, z float64) float64 {
+	h := hash & 15
+
+	u := y
+	if h < 8 {
+		u = x
+	}
+
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
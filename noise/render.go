@@ -0,0 +1,41 @@
+package noise
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Render fills dst by sampling noise from -1 to 1 at every pixel, scaled by scale, and
+// looking the value up in the palette. Pass a closure to render fractals, slices of 3D
+// noise or anything else:
+//
+//	noise.Render(dst, palette, 0.02, func(x, y float64) float64 {
+//		return fractal.FBm3D(x, y, t)
+//	})
+func Render(dst *buffers.PixelBuffer, palette []colour.Colour, scale float64, sample func(x, y float64) float64) {
+	RenderRange(dst, palette, scale, -1, 1, sample)
+}
+
+// ------------------------------------------------------------------------------------------------
+// RenderRange is Render for samples from low to high, such as turbulence and ridged noise,
+// which go from 0 to 1. Values outside the range use the first or last palette entry.
+func RenderRange(dst *buffers.PixelBuffer, palette []colour.Colour, scale, low, high float64, sample func(x, y float64) float64) {
+	if len(palette) == 0 || high <= low {
+		return
+	}
+
+	for y := range dst.Height() {
+		for x := range dst.Width() {
+			value := (sample(float64(x)*scale, float64(y)*scale) - low) / (high - low)
+			dst.ColourPutPixel(x, y, paletteColour(palette, value))
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// paletteColour picks the palette entry for a value from 0 to 1, clamping values outside it.
+func paletteColour(palette []colour.Colour, value float64) colour.Colour {
+	index := int(value * float64(len(palette)-1))
+	return palette[max(0, min(len(palette)-1, index))]
+}
@@ -0,0 +1,69 @@
+package noise
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestRender(t *testing.T) {
+	black, grey, white := colour.NewColourBlack(), colour.NewColour(128, 128, 128, 255), colour.NewColourWhite()
+	palette := []colour.Colour{black, grey, white}
+
+	tests := []struct {
+		name      string
+		render    func(dst *buffers.PixelBuffer)
+		left      colour.Colour
+		right     colour.Colour
+		untouched bool
+	}{
+		{
+			name: "full range",
+			render: func(dst *buffers.PixelBuffer) {
+				Render(dst, palette, 1, func(x, y float64) float64 { return x - 1 })
+			},
+			left: black, right: white,
+		},
+		{
+			name: "custom range",
+			render: func(dst *buffers.PixelBuffer) {
+				RenderRange(dst, palette, 0.5, 0, 0.5, func(x, y float64) float64 { return x })
+			},
+			left: black, right: white,
+		},
+		{
+			name: "clamped",
+			render: func(dst *buffers.PixelBuffer) {
+				Render(dst, palette, 1, func(x, y float64) float64 { return 5 - 10*x })
+			},
+			left: white, right: black,
+		},
+		{
+			name: "empty palette",
+			render: func(dst *buffers.PixelBuffer) {
+				Render(dst, nil, 1, func(x, y float64) float64 { return 0 })
+			},
+			untouched: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := buffers.NewPixelBuffer(3, 2, make([]uint8, 3*2*buffers.RGBABytesPerPixel))
+			tt.render(dst)
+
+			if tt.untouched {
+				if dst.GetPixel(1, 1) != colour.NewColourEmpty() {
+					t.Errorf("Expected the buffer to be left alone, but got %v", dst.GetPixel(1, 1))
+				}
+				return
+			}
+
+			if dst.GetPixel(0, 1) != tt.left || dst.GetPixel(2, 1) != tt.right {
+				t.Errorf("Expected %v to %v, but got %v to %v", tt.left, tt.right, dst.GetPixel(0, 1), dst.GetPixel(2, 1))
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package noise
+
+// ------------------------------------------------------------------------------------------------
+// Value is value noise, smoothly blending random values picked at the lattice points. It is
+// cheaper than gradient noise but looks blockier.
+type Value struct {
+	permutation
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewValue(seed uint64) *Value {
+	n := &Value{}
+	n.Seed(seed)
+	return n
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Value) Noise1D(x float64) float64 {
+	xi, xf := floor(x)
+	return lerp(toSigned(n.hash1(xi)), toSigned(n.hash1(xi+1)), fade(xf))
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Value) Noise2D(x, y float64) float64 {
+	xi, xf := floor(x)
+	yi, yf := floor(y)
+	u, v := fade(xf), fade(yf)
+
+	bottom := lerp(toSigned(n.hash2(xi, yi)), toSigned(n.hash2(xi+1, yi)), u)
+	top := lerp(toSigned(n.hash2(xi, yi+1)), toSigned(n.hash2(xi+1, yi+1)), u)
+	return lerp(bottom, top, v)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Value) Noise3D(x, y, z float64) float64 {
+	xi, xf := floor(x)
+	yi, yf := floor(y)
+	zi, zf := floor(z)
+	u, v, w := fade(xf), fade(yf), fade(zf)
+
+	corner := func(dx, dy, dz int) float64 {
+		return toSigned(n.hash3(xi+dx, yi+dy, zi+dz))
+	}
+
+	near := lerp(lerp(corner(0, 0, 0), corner(1, 0, 0), u), lerp(corner(0, 1, 0), corner(1, 1, 0), u), v)
+	far := lerp(lerp(corner(0, 0, 1), corner(1, 0, 1), u), lerp(corner(0, 1, 1), corner(1, 1, 1), u), v)
+	return lerp(near, far, w)
+}
+
+// ------------------------------------------------------------------------------------------------
+// toSigned maps a hash to a value from -1 to 1.
+func toSigned(hash uint8) float64 {
+	return float64(hash)/127.5 - 1
+}
@@ -0,0 +1,139 @@
+package noise
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+// Distance is how Worley noise measures the distance to feature points, which sets the shape
+// of its cells.
+type Distance int
+
+// ------------------------------------------------------------------------------------------------
+const (
+	// DistanceEuclidean gives round cells, like cracked mud or scales.
+	DistanceEuclidean Distance = iota
+	// DistanceManhattan adds the distances along each axis, giving diamond shapes.
+	DistanceManhattan
+	// DistanceChebyshev takes the largest distance along any axis, giving square shapes.
+	DistanceChebyshev
+)
+
+// ------------------------------------------------------------------------------------------------
+// Worley is cellular noise. Every lattice cell holds one feature point at a random spot, and
+// the noise is the distance to the nearest one. Distances2D and friends also return the
+// distance to the second nearest point, as f2 - f1 outlines the cells.
+type Worley struct {
+	permutation
+	Distance Distance
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewWorley(seed uint64) *Worley {
+	n := &Worley{}
+	n.Seed(seed)
+	return n
+}
+
+// ------------------------------------------------------------------------------------------------
+// Noise1D maps the distance to the nearest feature point, from 0 to 1, onto -1 to 1.
+func (n *Worley) Noise1D(x float64) float64 {
+	f1, _ := n.Distances1D(x)
+	return toNoise(f1)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Worley) Noise2D(x, y float64) float64 {
+	f1, _ := n.Distances2D(x, y)
+	return toNoise(f1)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Worley) Noise3D(x, y, z float64) float64 {
+	f1, _ := n.Distances3D(x, y, z)
+	return toNoise(f1)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Distances1D returns the distances to the nearest and second nearest feature points.
+func (n *Worley) Distances1D(x float64) (f1, f2 float64) {
+	xi, _ := floor(x)
+	f1, f2 = math.Inf(1), math.Inf(1)
+
+	for cx := xi - 1; cx <= xi+1; cx++ {
+		hash := n.hash1(cx)
+		f1, f2 = nearest(f1, f2, math.Abs(float64(cx)+n.offset(hash, 0)-x))
+	}
+
+	return f1, f2
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Worley) Distances2D(x, y float64) (f1, f2 float64) {
+	xi, _ := floor(x)
+	yi, _ := floor(y)
+	f1, f2 = math.Inf(1), math.Inf(1)
+
+	for cy := yi - 1; cy <= yi+1; cy++ {
+		for cx := xi - 1; cx <= xi+1; cx++ {
+			hash := n.hash2(cx, cy)
+			dx := float64(cx) + n.offset(hash, 0) - x
+			dy := float64(cy) + n.offset(hash, 1) - y
+			f1, f2 = nearest(f1, f2, n.measure(dx, dy, 0))
+		}
+	}
+
+	return f1, f2
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Worley) Distances3D(x, y, z float64) (f1, f2 float64) {
+	xi, _ := floor(x)
+	yi, _ := floor(y)
+	zi, _ := floor(z)
+	f1, f2 = math.Inf(1), math.Inf(1)
+
+	for cz := zi - 1; cz <= zi+1; cz++ {
+		for cy := yi - 1; cy <= yi+1; cy++ {
+			for cx := xi - 1; cx <= xi+1; cx++ {
+				hash := n.hash3(cx, cy, cz)
+				dx := float64(cx) + n.offset(hash, 0) - x
+				dy := float64(cy) + n.offset(hash, 1) - y
+				dz := float64(cz) + n.offset(hash, 2) - z
+				f1, f2 = nearest(f1, f2, n.measure(dx, dy, dz))
+			}
+		}
+	}
+
+	return f1, f2
+}
+
+// ------------------------------------------------------------------------------------------------
+// offset places the feature point of a cell along one axis, from 0 to just under 1.
+func (n *Worley) offset(hash uint8, axis int) float64 {
+	return float64(n.permutation[int(hash)+axis]) / 256
+}
+
+// ------------------------------------------------------------------------------------------------
+func (n *Worley) measure(dx, dy, dz float64) float64 {
+	switch n.Distance {
+	case DistanceManhattan:
+		return math.Abs(dx) + math.Abs(dy) + math.Abs(dz)
+	case DistanceChebyshev:
+		return max(math.Abs(dx), math.Abs(dy), math.Abs(dz))
+	default:
+		return math.Sqrt(dx*dx + dy*dy + dz*dz)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// nearest keeps the two smallest distances seen so far.
+func nearest(f1, f2, distance float64) (float64, float64) {
+	if distance < f1 {
+		return distance, f1
+	}
+	return f1, min(f2, distance)
+}
+
+// ------------------------------------------------------------------------------------------------
+func toNoise(distance float64) float64 {
+	return min(1, distance*2-1)
+}
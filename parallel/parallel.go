@@ -0,0 +1,8 @@
+//go:build !tinygo
+
+package parallel
+
+// ------------------------------------------------------------------------------------------------
+// parallelGoroutines reports whether goroutines can run at the same time. With the standard
+// Go runtime they can, although GOMAXPROCS may still limit the pool to one worker.
+const parallelGoroutines = true
@@ -0,0 +1,8 @@
+//go:build tinygo
+
+package parallel
+
+// ------------------------------------------------------------------------------------------------
+// parallelGoroutines is false for TinyGo, which schedules goroutines on a single thread, so a
+// worker pool would only add overhead.
+const parallelGoroutines = false
@@ -0,0 +1,123 @@
+// Package parallel splits rendering work into bands of rows and hands them to a pool of
+// worker goroutines. Every band is drawn by exactly one worker, so as long as rows do not
+// depend on each other the result is identical to drawing everything on one goroutine.
+// Where goroutines cannot run in parallel, like TinyGo WebAssembly, the pool is skipped
+// and everything is drawn on the calling goroutine.
+package parallel
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/effects"
+)
+
+// ------------------------------------------------------------------------------------------------
+// BANDS_PER_WORKER splits the work finer than one band per worker, so a worker that finishes
+// early can pick up more instead of waiting for the slowest band.
+const BANDS_PER_WORKER = 4
+
+// ------------------------------------------------------------------------------------------------
+// BandFunc draws the rows from y0 up to y1.
+type BandFunc func(y0, y1 int)
+
+// ------------------------------------------------------------------------------------------------
+type band struct {
+	y0, y1 int
+	draw   BandFunc
+	done   *sync.WaitGroup
+}
+
+// ------------------------------------------------------------------------------------------------
+// Renderer owns the worker pool. A Renderer is not meant to be used from several goroutines
+// at once.
+type Renderer struct {
+	workers int
+	jobs    chan band
+	done    sync.WaitGroup
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewRenderer starts a pool of workers, with 0 or less meaning one per CPU. With a single
+// worker, or without parallel goroutines, no pool is started.
+func NewRenderer(workers int) *Renderer {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if !parallelGoroutines {
+		workers = 1
+	}
+
+	r := &Renderer{workers: workers}
+	if workers > 1 {
+		r.jobs = make(chan band, workers*BANDS_PER_WORKER)
+		for range workers {
+			go work(r.jobs)
+		}
+	}
+
+	return r
+}
+
+// ------------------------------------------------------------------------------------------------
+// Workers returns the number of goroutines drawing bands, 1 when drawing sequentially.
+func (r *Renderer) Workers() int {
+	return r.workers
+}
+
+// ------------------------------------------------------------------------------------------------
+func work(jobs <-chan band) {
+	for job := range jobs {
+		job.draw(job.y0, job.y1)
+		job.done.Done()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Run splits height rows into bands and draws them, returning once every band is done.
+func (r *Renderer) Run(height int, draw BandFunc) {
+	if height <= 0 {
+		return
+	}
+
+	if r.jobs == nil {
+		draw(0, height)
+		return
+	}
+
+	bands := min(height, r.workers*BANDS_PER_WORKER)
+	bandHeight := (height + bands - 1) / bands
+
+	r.done.Add((height + bandHeight - 1) / bandHeight)
+	for y := 0; y < height; y += bandHeight {
+		r.jobs <- band{y0: y, y1: min(height, y+bandHeight), draw: draw, done: &r.done}
+	}
+	r.done.Wait()
+}
+
+// ------------------------------------------------------------------------------------------------
+// RenderEffect draws a frame of an effect into dst. Effects that can draw bands on their own
+// are split across the workers, any others are drawn in one go.
+func (r *Renderer) RenderEffect(effect effects.Effect, dst *buffers.PixelBuffer) {
+	bandRenderer, ok := effect.(effects.BandRenderer)
+	if !ok {
+		effect.Render(dst)
+		return
+	}
+
+	bandRenderer.PrepareBands(dst)
+	r.Run(dst.Height(), func(y0, y1 int) {
+		bandRenderer.RenderBand(dst, y0, y1)
+	})
+}
+
+// ------------------------------------------------------------------------------------------------
+// Close stops the workers. The renderer keeps working afterwards, drawing sequentially.
+func (r *Renderer) Close() {
+	if r.jobs != nil {
+		close(r.jobs)
+		r.jobs = nil
+		r.workers = 1
+	}
+}
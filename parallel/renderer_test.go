@@ -0,0 +1,112 @@
+package parallel
+
+import (
+	"bytes"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/effects"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestRunCoversEveryRowOnce(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		height  int
+	}{
+		{name: "sequential", workers: 1, height: 50},
+		{name: "more rows than bands", workers: 3, height: 101},
+		{name: "fewer rows than bands", workers: 4, height: 5},
+		{name: "single row", workers: 8, height: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRenderer(tt.workers)
+			defer r.Close()
+
+			counts := make([]atomic.Int32, tt.height)
+			r.Run(tt.height, func(y0, y1 int) {
+				for y := y0; y < y1; y++ {
+					counts[y].Add(1)
+				}
+			})
+
+			for y := range counts {
+				if got := counts[y].Load(); got != 1 {
+					t.Errorf("Expected row %d to be drawn once, but it was drawn %d times", y, got)
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRenderEffectMatchesSequential(t *testing.T) {
+	tests := []struct {
+		name   string
+		effect func() effects.Effect
+	}{
+		{name: "plasma", effect: func() effects.Effect { return effects.NewPlasma() }},
+		{name: "tunnel", effect: func() effects.Effect { return effects.NewTunnel() }},
+		{name: "rotozoomer", effect: func() effects.Effect { return effects.NewRotozoomer() }},
+		{name: "metaballs", effect: func() effects.Effect { return effects.NewMetaballs(5) }},
+		{name: "copper bars", effect: func() effects.Effect { return effects.NewCopperBars(5) }},
+		{name: "water", effect: func() effects.Effect {
+			w := effects.NewWater(30, 20)
+			w.DropRate = 0
+			w.Disturb(10, 10, 2000)
+			return w
+		}},
+		{name: "starfield without bands", effect: func() effects.Effect {
+			s := effects.NewStarfield(100)
+			s.Seed(3)
+			return s
+		}},
+	}
+
+	r := NewRenderer(4)
+	defer r.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			effect := tt.effect()
+			effect.Update(0.75)
+
+			sequential, parallel := make([]uint8, 97*61*buffers.RGBABytesPerPixel), make([]uint8, 97*61*buffers.RGBABytesPerPixel)
+			effect.Render(buffers.WrapPixelBuffer(97, 61, sequential))
+			r.RenderEffect(effect, buffers.WrapPixelBuffer(97, 61, parallel))
+
+			if !bytes.Equal(sequential, parallel) {
+				t.Error("Expected the parallel frame to match the sequential one")
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestCloseFallsBackToSequential(t *testing.T) {
+	r := NewRenderer(3)
+	r.Close()
+
+	if r.Workers() != 1 {
+		t.Errorf("Expected 1 worker after closing, but got %d", r.Workers())
+	}
+
+	calls := 0
+	r.Run(10, func(y0, y1 int) {
+		calls++
+		if y0 != 0 || y1 != 10 {
+			t.Errorf("Expected one band covering every row, but got %d to %d", y0, y1)
+		}
+	})
+
+	if calls != 1 {
+		t.Errorf("Expected a single call, but got %d", calls)
+	}
+
+	// closing twice is harmless
+	r.Close()
+}
@@ -0,0 +1,151 @@
+package particles
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/randomness"
+	"github.com/ewaldhorn/gogi/vecmath"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Shape picks the position new particles start at.
+type Shape interface {
+	Position(random *randomness.Source) (x, y float64)
+}
+
+// ------------------------------------------------------------------------------------------------
+// PointShape emits every particle from the same spot.
+type PointShape struct {
+	X, Y float64
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *PointShape) Position(random *randomness.Source) (x, y float64) {
+	return s.X, s.Y
+}
+
+// ------------------------------------------------------------------------------------------------
+// LineShape emits particles anywhere along the line from X0,Y0 to X1,Y1.
+type LineShape struct {
+	X0, Y0, X1, Y1 float64
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *LineShape) Position(random *randomness.Source) (x, y float64) {
+	t := random.Float64()
+	return s.X0 + (s.X1-s.X0)*t, s.Y0 + (s.Y1-s.Y0)*t
+}
+
+// ------------------------------------------------------------------------------------------------
+// CircleShape emits particles anywhere inside a circle, evenly spread over its area.
+type CircleShape struct {
+	X, Y, Radius float64
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *CircleShape) Position(random *randomness.Source) (x, y float64) {
+	return random.InCircle(s.X, s.Y, s.Radius)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Emitter spawns particles from a shape at a steady rate. New particles head off in
+// Direction, give or take Spread radians, with a speed and lifetime picked between the
+// minimum and maximum. Angles are in radians with 0 pointing right and positive angles
+// turning down the screen.
+type Emitter struct {
+	Shape Shape
+
+	// Rate is the number of particles emitted per second.
+	Rate float64
+
+	Direction, Spread        float64
+	MinSpeed, MaxSpeed       float64
+	MinLifetime, MaxLifetime float64
+	Size                     float64
+
+	active  bool
+	pending float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewEmitter creates an active emitter for a shape, sending one particle per second straight
+// up for a second. Adjust the fields to taste.
+func NewEmitter(shape Shape) *Emitter {
+	return &Emitter{
+		Shape:       shape,
+		Rate:        1,
+		Direction:   -math.Pi / 2,
+		MinSpeed:    10,
+		MaxSpeed:    10,
+		MinLifetime: 1,
+		MaxLifetime: 1,
+		Size:        1,
+		active:      true,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewPointEmitter(x, y float64) *Emitter {
+	return NewEmitter(&PointShape{X: x, Y: y})
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewLineEmitter(x0, y0, x1, y1 float64) *Emitter {
+	return NewEmitter(&LineShape{X0: x0, Y0: y0, X1: x1, Y1: y1})
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewCircleEmitter(x, y, radius float64) *Emitter {
+	return NewEmitter(&CircleShape{X: x, Y: y, Radius: radius})
+}
+
+// ------------------------------------------------------------------------------------------------
+func (e *Emitter) IsActive() bool {
+	return e.active
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetActive starts or stops the steady stream of particles. Bursts still work while stopped.
+func (e *Emitter) SetActive(active bool) {
+	e.active = active
+	if !active {
+		e.pending = 0
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// due returns how many particles the emitter should spawn after dt seconds, carrying the
+// fraction over to the next update so low rates still emit.
+func (e *Emitter) due(dt float64) int {
+	if !e.active || e.Rate <= 0 {
+		return 0
+	}
+
+	e.pending += e.Rate * dt
+	count := int(e.pending)
+	e.pending -= float64(count)
+	return count
+}
+
+// ------------------------------------------------------------------------------------------------
+// spawn initialises p as a fresh particle from this emitter.
+func (e *Emitter) spawn(p *Particle, random *randomness.Source) {
+	p.X, p.Y = e.Shape.Position(random)
+
+	angle := e.Direction + (random.Float64()*2-1)*e.Spread
+	speed := between(random, e.MinSpeed, e.MaxSpeed)
+	velocity := vecmath.FromAngle(angle).Scale(speed)
+	p.VX, p.VY = velocity.X, velocity.Y
+
+	p.Age = 0
+	p.Lifetime = between(random, e.MinLifetime, e.MaxLifetime)
+	p.Size = e.Size
+}
+
+// ------------------------------------------------------------------------------------------------
+func between(random *randomness.Source, low, high float64) float64 {
+	if high <= low {
+		return low
+	}
+	return low + random.Float64()*(high-low)
+}
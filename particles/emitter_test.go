@@ -0,0 +1,89 @@
+package particles
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestShapePositions(t *testing.T) {
+	random := randomness.NewSource(1)
+
+	tests := []struct {
+		name   string
+		shape  Shape
+		inside func(x, y float64) bool
+	}{
+		{
+			name:   "point",
+			shape:  &PointShape{X: 3, Y: 4},
+			inside: func(x, y float64) bool { return x == 3 && y == 4 },
+		},
+		{
+			name:  "line",
+			shape: &LineShape{X0: 0, Y0: 10, X1: 20, Y1: 10},
+			inside: func(x, y float64) bool {
+				return x >= 0 && x <= 20 && y == 10
+			},
+		},
+		{
+			name:  "circle",
+			shape: &CircleShape{X: 50, Y: 50, Radius: 5},
+			inside: func(x, y float64) bool {
+				return math.Hypot(x-50, y-50) <= 5
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for range 100 {
+				if x, y := tt.shape.Position(random); !tt.inside(x, y) {
+					t.Fatalf("Expected positions inside the shape, but got %f,%f", x, y)
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEmitterRateCarriesFractions(t *testing.T) {
+	e := NewPointEmitter(0, 0)
+	e.Rate = 10
+
+	total := 0
+	for range 25 {
+		total += e.due(0.01)
+	}
+
+	if total != 2 {
+		t.Errorf("Expected 2 particles after a quarter second at 10 per second, but got %d", total)
+	}
+
+	e.SetActive(false)
+	if e.due(1) != 0 {
+		t.Error("Expected an inactive emitter not to emit")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEmitterSpawn(t *testing.T) {
+	e := NewPointEmitter(5, 5)
+	e.Direction = 0
+	e.MinSpeed, e.MaxSpeed = 20, 20
+	e.MinLifetime, e.MaxLifetime = 2, 3
+	e.Size = 4
+
+	var p Particle
+	e.spawn(&p, randomness.NewSource(1))
+
+	if p.X != 5 || p.Y != 5 || math.Abs(p.VX-20) > 1e-9 || math.Abs(p.VY) > 1e-9 {
+		t.Errorf("Expected a particle at 5,5 moving right at 20, but got %+v", p)
+	}
+
+	if p.Lifetime < 2 || p.Lifetime > 3 || p.Size != 4 || p.Age != 0 {
+		t.Errorf("Unexpected lifetime or size %+v", p)
+	}
+}
@@ -0,0 +1,166 @@
+// Package particles animates short lived particles for effects like sparks, smoke and fire.
+// A System owns a fixed pool of particles, so nothing is allocated while it runs, and any
+// number of emitters feeding it. Particles move under gravity and drag, change colour over
+// their life by walking through a palette, and draw onto a GogiCanvas as pixels, circles or
+// sprites.
+package particles
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/randomness"
+)
+
+// ------------------------------------------------------------------------------------------------
+type Particle struct {
+	X, Y     float64
+	VX, VY   float64
+	Age      float64
+	Lifetime float64
+	Size     float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// Life returns how far through its life the particle is, from 0 when born to 1 when it dies.
+func (p *Particle) Life() float64 {
+	if p.Lifetime <= 0 {
+		return 1
+	}
+	return min(1, p.Age/p.Lifetime)
+}
+
+// ------------------------------------------------------------------------------------------------
+type RenderMode int
+
+const (
+	RenderPixels RenderMode = iota
+	RenderCircles
+	RenderSprites
+)
+
+// ------------------------------------------------------------------------------------------------
+// System updates and draws a pool of particles. Living particles are kept at the start of
+// the pool, so dead ones are reused without searching.
+type System struct {
+	particles []Particle
+	count     int
+	emitters  []*Emitter
+	random    *randomness.Source
+
+	// GravityX and GravityY accelerate every particle, in pixels per second squared.
+	GravityX, GravityY float64
+	// Drag is the fraction of velocity lost per second.
+	Drag float64
+
+	// Palette colours particles over their life, from the first entry at birth to the last
+	// at death. An empty palette draws white.
+	Palette []colour.Colour
+	// Fade scales alpha down as particles age, on top of the palette.
+	Fade bool
+
+	Mode RenderMode
+	// Sprite is drawn centred on each particle in RenderSprites mode, tinted by its colour.
+	Sprite *buffers.PixelBuffer
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewSystem creates a system with room for maxParticles living particles. Emitting more
+// than that quietly drops the extra particles.
+func NewSystem(maxParticles int) *System {
+	return &System{
+		particles: make([]Particle, max(0, maxParticles)),
+		random:    randomness.NewTimeSeededSource(),
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Seed makes the emitted particles repeatable.
+func (s *System) Seed(seed uint64) {
+	s.random.Seed(seed)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *System) AddEmitter(e *Emitter) {
+	s.emitters = append(s.emitters, e)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *System) RemoveEmitter(e *Emitter) bool {
+	for i, existing := range s.emitters {
+		if existing == e {
+			s.emitters = append(s.emitters[:i], s.emitters[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *System) Emitters() []*Emitter {
+	return s.emitters
+}
+
+// ------------------------------------------------------------------------------------------------
+// Count returns the number of living particles.
+func (s *System) Count() int {
+	return s.count
+}
+
+// ------------------------------------------------------------------------------------------------
+// Capacity returns the size of the pool.
+func (s *System) Capacity() int {
+	return len(s.particles)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Particles returns the living particles. The slice is only valid until the next update.
+func (s *System) Particles() []Particle {
+	return s.particles[:s.count]
+}
+
+// ------------------------------------------------------------------------------------------------
+// Clear kills every particle.
+func (s *System) Clear() {
+	s.count = 0
+}
+
+// ------------------------------------------------------------------------------------------------
+// Burst emits count particles from e at once, returning how many fitted in the pool. The
+// emitter does not need to be added to the system.
+func (s *System) Burst(e *Emitter, count int) int {
+	emitted := 0
+	for ; emitted < count && s.count < len(s.particles); emitted++ {
+		e.spawn(&s.particles[s.count], s.random)
+		s.count++
+	}
+	return emitted
+}
+
+// ------------------------------------------------------------------------------------------------
+// Update moves the system on by dt seconds, ageing and moving the living particles before
+// letting the emitters add new ones.
+func (s *System) Update(dt float64) {
+	drag := max(0, 1-s.Drag*dt)
+
+	for i := 0; i < s.count; {
+		p := &s.particles[i]
+
+		p.Age += dt
+		if p.Age >= p.Lifetime {
+			// move the last living particle into this slot and look at it next
+			s.count--
+			s.particles[i] = s.particles[s.count]
+			continue
+		}
+
+		p.VX = (p.VX + s.GravityX*dt) * drag
+		p.VY = (p.VY + s.GravityY*dt) * drag
+		p.X += p.VX * dt
+		p.Y += p.VY * dt
+		i++
+	}
+
+	for _, e := range s.emitters {
+		s.Burst(e, e.due(dt))
+	}
+}
@@ -0,0 +1,173 @@
+package particles
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestSystemPoolLimit(t *testing.T) {
+	s := NewSystem(5)
+
+	if emitted := s.Burst(NewPointEmitter(0, 0), 8); emitted != 5 {
+		t.Errorf("Expected 5 particles to fit, but got %d", emitted)
+	}
+
+	if s.Count() != 5 || s.Capacity() != 5 {
+		t.Errorf("Expected a full pool of 5, but got %d of %d", s.Count(), s.Capacity())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSystemUpdateMovesAndExpires(t *testing.T) {
+	s := NewSystem(10)
+	s.GravityY = 10
+
+	e := NewPointEmitter(0, 0)
+	e.Direction = 0
+	e.MinLifetime, e.MaxLifetime = 1, 1
+	s.Burst(e, 1)
+
+	e.MinLifetime, e.MaxLifetime = 0.3, 0.3
+	s.Burst(e, 1)
+
+	s.Update(0.5)
+
+	if s.Count() != 1 {
+		t.Fatalf("Expected the short lived particle to die, but %d are alive", s.Count())
+	}
+
+	p := s.Particles()[0]
+	if math.Abs(p.VY-5) > 1e-9 || math.Abs(p.Y-2.5) > 1e-9 || math.Abs(p.X-5) > 1e-9 {
+		t.Errorf("Expected gravity to pull the particle down, but got %+v", p)
+	}
+
+	s.Update(0.6)
+	if s.Count() != 0 {
+		t.Errorf("Expected every particle to be dead, but %d are alive", s.Count())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSystemDrag(t *testing.T) {
+	s := NewSystem(1)
+	s.Drag = 0.5
+
+	e := NewPointEmitter(0, 0)
+	e.MinLifetime, e.MaxLifetime = 2, 2
+	s.Burst(e, 1)
+
+	s.Update(1)
+
+	if speed := math.Hypot(s.Particles()[0].VX, s.Particles()[0].VY); math.Abs(speed-5) > 1e-9 {
+		t.Errorf("Expected drag to halve the speed to 5, but got %f", speed)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSystemEmittersFeedPool(t *testing.T) {
+	s := NewSystem(100)
+	s.Seed(42)
+
+	e := NewCircleEmitter(50, 50, 10)
+	e.Rate = 20
+	e.MinLifetime, e.MaxLifetime = 10, 10
+	s.AddEmitter(e)
+
+	for range 10 {
+		s.Update(0.1)
+	}
+
+	if s.Count() != 20 {
+		t.Errorf("Expected 20 particles after a second, but got %d", s.Count())
+	}
+
+	if !s.RemoveEmitter(e) || len(s.Emitters()) != 0 {
+		t.Error("Expected the emitter to be removed")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestColourOverLife(t *testing.T) {
+	red := colour.NewColour(255, 0, 0, 255)
+	blue := colour.NewColour(0, 0, 255, 255)
+
+	s := NewSystem(1)
+	s.Palette = []colour.Colour{red, blue}
+
+	tests := []struct {
+		name     string
+		age      float64
+		fade     bool
+		expected colour.Colour
+	}{
+		{name: "birth", age: 0, expected: red},
+		{name: "death", age: 2, expected: blue},
+		{name: "faded halfway", age: 1, fade: true, expected: colour.NewColour(255, 0, 0, 127)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.Fade = tt.fade
+			p := Particle{Age: tt.age, Lifetime: 2}
+
+			if got := s.ColourOf(&p); got != tt.expected {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDrawModes(t *testing.T) {
+	sprite := buffers.NewPixelBuffer(3, 3, make([]uint8, 3*3*4))
+	sprite.ColourPutPixel(1, 1, colour.NewColourWhite())
+	sprite.ColourPutPixel(2, 1, colour.NewColour(128, 128, 128, 255))
+
+	tests := []struct {
+		name     string
+		mode     RenderMode
+		expected int
+	}{
+		{name: "pixels", mode: RenderPixels, expected: 1},
+		{name: "circles", mode: RenderCircles, expected: 21},
+		{name: "sprites", mode: RenderSprites, expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSystem(1)
+			s.Mode = tt.mode
+			s.Sprite = sprite
+			s.Palette = []colour.Colour{colour.NewColour(0, 255, 0, 255)}
+
+			e := NewPointEmitter(10, 10)
+			e.Size = 2
+			s.Burst(e, 1)
+
+			c := canvas.NewCanvas(20, 20)
+			s.Draw(c)
+
+			drawn := 0
+			for y := range 20 {
+				for x := range 20 {
+					if c.GetPixel(x, y).G != 0 {
+						drawn++
+					}
+				}
+			}
+
+			if drawn != tt.expected {
+				t.Errorf("Expected %d pixels drawn, but got %d", tt.expected, drawn)
+			}
+		})
+	}
+
+	if got := multiply(128, 255); got != 128 {
+		t.Errorf("Expected the tint to keep 128, but got %d", got)
+	}
+}
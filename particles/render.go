@@ -0,0 +1,73 @@
+package particles
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Draw renders the living particles onto the canvas using the system's render mode.
+// Sprite mode without a sprite falls back to pixels.
+func (s *System) Draw(c *canvas.GogiCanvas) {
+	for i := range s.count {
+		p := &s.particles[i]
+		col := s.ColourOf(p)
+		x, y := int(math.Round(p.X)), int(math.Round(p.Y))
+
+		switch {
+		case s.Mode == RenderCircles && p.Size > 1:
+			c.DrawFilledCircle(x, y, int(math.Round(p.Size)), col)
+		case s.Mode == RenderSprites && s.Sprite != nil:
+			s.drawSprite(c, x, y, col)
+		default:
+			c.ColourPutPixel(x, y, col)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// ColourOf returns the colour a particle is drawn with at its current age.
+func (s *System) ColourOf(p *Particle) colour.Colour {
+	life := p.Life()
+
+	col := colour.NewColourWhite()
+	if len(s.Palette) > 0 {
+		col = s.Palette[int(life*float64(len(s.Palette)-1))]
+	}
+
+	if s.Fade {
+		col.A = uint8(float64(col.A) * (1 - life))
+	}
+
+	return col
+}
+
+// ------------------------------------------------------------------------------------------------
+// drawSprite draws the sprite centred on x,y, multiplying each sprite pixel by the tint.
+func (s *System) drawSprite(c *canvas.GogiCanvas, x, y int, tint colour.Colour) {
+	width, height := s.Sprite.Width(), s.Sprite.Height()
+	left, top := x-width/2, y-height/2
+
+	for sy := range height {
+		for sx := range width {
+			pixel := s.Sprite.GetPixel(sx, sy)
+			if pixel.A == 0 {
+				continue
+			}
+
+			c.ColourPutPixel(left+sx, top+sy, colour.NewColour(
+				multiply(pixel.R, tint.R),
+				multiply(pixel.G, tint.G),
+				multiply(pixel.B, tint.B),
+				multiply(pixel.A, tint.A),
+			))
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func multiply(a, b uint8) uint8 {
+	return uint8(uint16(a) * uint16(b) / 255)
+}
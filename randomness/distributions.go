@@ -0,0 +1,122 @@
+package randomness
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+// POISSON_SMALL_MEAN is the mean below which Poisson counts are drawn by multiplying uniform
+// values, which takes about mean draws. Larger means use a rejection method instead.
+const POISSON_SMALL_MEAN = 30
+
+// ------------------------------------------------------------------------------------------------
+// Gaussian returns a normally distributed number, using Marsaglia's polar method. About 68%
+// of values are within one standard deviation of the mean.
+func (s *Source) Gaussian(mean, stdDev float64) float64 {
+	for {
+		u := s.Float64()*2 - 1
+		v := s.Float64()*2 - 1
+		r := u*u + v*v
+		if r > 0 && r < 1 {
+			return mean + stdDev*u*math.Sqrt(-2*math.Log(r)/r)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Exponential returns the time until the next event when events happen rate times per unit
+// on average, such as the gap between sparks. The mean is 1 / rate.
+func (s *Source) Exponential(rate float64) float64 {
+	// 1 - Float64 is never zero, so the log is always finite
+	return -math.Log(1-s.Float64()) / rate
+}
+
+// ------------------------------------------------------------------------------------------------
+// Poisson returns how many events happen in a unit of time when mean events happen on
+// average, such as the number of particles to spawn in a frame.
+func (s *Source) Poisson(mean float64) int {
+	if mean <= 0 {
+		return 0
+	}
+
+	if mean < POISSON_SMALL_MEAN {
+		// Knuth's method, count the uniforms multiplied until the product drops below e^-mean
+		limit := math.Exp(-mean)
+		count, product := 0, s.Float64()
+		for product > limit {
+			count++
+			product *= s.Float64()
+		}
+		return count
+	}
+
+	return s.poissonPTRS(mean)
+}
+
+// ------------------------------------------------------------------------------------------------
+// poissonPTRS is Hörmann's transformed rejection with squeeze, which takes about one try
+// whatever the mean.
+func (s *Source) poissonPTRS(mean float64) int {
+	root, logMean := math.Sqrt(mean), math.Log(mean)
+	b := 0.931 + 2.53*root
+	a := -0.059 + 0.02483*b
+	inverseAlpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+
+	for {
+		u := s.Float64() - 0.5
+		v := s.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + mean + 0.43)
+
+		if us >= 0.07 && v <= vr {
+			return int(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+
+		logFactorial, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(inverseAlpha)-math.Log(a/(us*us)+b) <= -mean+k*logMean-logFactorial {
+			return int(k)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WeightedChoice returns an index into weights, picked in proportion to its weight. Negative
+// weights count as zero. It returns -1 when there is nothing to pick.
+func (s *Source) WeightedChoice(weights []float64) int {
+	total := 0.0
+	for _, weight := range weights {
+		total += max(0, weight)
+	}
+	if total <= 0 {
+		return -1
+	}
+
+	target := s.Float64() * total
+	last := -1
+	for i, weight := range weights {
+		if weight <= 0 {
+			continue
+		}
+		target -= weight
+		if target < 0 {
+			return i
+		}
+		last = i
+	}
+
+	// rounding can leave a sliver at the end, which belongs to the last weighted entry
+	return last
+}
+
+// ------------------------------------------------------------------------------------------------
+// Shuffle randomises the order of n items with the Fisher-Yates shuffle, calling swap to
+// exchange two of them. Use it like sort.Slice:
+//
+//	source.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+func (s *Source) Shuffle(n int, swap func(i, j int)) {
+	for i := n - 1; i > 0; i-- {
+		swap(i, s.IntN(i+1))
+	}
+}
@@ -0,0 +1,126 @@
+package randomness
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+// moments returns the mean and variance of n draws.
+func moments(n int, draw func() float64) (mean, variance float64) {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = draw()
+		mean += values[i]
+	}
+	mean /= float64(n)
+
+	for _, value := range values {
+		variance += (value - mean) * (value - mean)
+	}
+	return mean, variance / float64(n)
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDistributionMoments(t *testing.T) {
+	s := NewSource(21)
+
+	tests := []struct {
+		name           string
+		draw           func() float64
+		mean, variance float64
+		tolerance      float64
+	}{
+		{name: "gaussian", draw: func() float64 { return s.Gaussian(5, 2) }, mean: 5, variance: 4, tolerance: 0.05},
+		{name: "exponential", draw: func() float64 { return s.Exponential(4) }, mean: 0.25, variance: 0.0625, tolerance: 0.05},
+		{name: "small poisson", draw: func() float64 { return float64(s.Poisson(3.5)) }, mean: 3.5, variance: 3.5, tolerance: 0.05},
+		{name: "large poisson", draw: func() float64 { return float64(s.Poisson(250)) }, mean: 250, variance: 250, tolerance: 0.05},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mean, variance := moments(100000, tt.draw)
+
+			if math.Abs(mean-tt.mean) > tt.mean*tt.tolerance {
+				t.Errorf("Expected a mean of about %f, but got %f", tt.mean, mean)
+			}
+			if math.Abs(variance-tt.variance) > tt.variance*tt.tolerance {
+				t.Errorf("Expected a variance of about %f, but got %f", tt.variance, variance)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPoissonEdgeCases(t *testing.T) {
+	s := NewSource(2)
+
+	if s.Poisson(0) != 0 || s.Poisson(-3) != 0 {
+		t.Error("Expected no events without a positive mean")
+	}
+
+	for range 1000 {
+		if s.Poisson(60) < 0 {
+			t.Fatal("Expected counts to never be negative")
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestWeightedChoice(t *testing.T) {
+	tests := []struct {
+		name     string
+		weights  []float64
+		expected []float64
+	}{
+		{name: "proportional", weights: []float64{1, 3, 0, 6}, expected: []float64{0.1, 0.3, 0, 0.6}},
+		{name: "negative ignored", weights: []float64{-5, 1, 1}, expected: []float64{0, 0.5, 0.5}},
+		{name: "single", weights: []float64{0, 0, 2}, expected: []float64{0, 0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewSource(8)
+			counts := make([]float64, len(tt.weights))
+
+			for range 50000 {
+				counts[s.WeightedChoice(tt.weights)]++
+			}
+
+			for i, count := range counts {
+				if math.Abs(count/50000-tt.expected[i]) > 0.01 {
+					t.Errorf("Expected index %d about %f of the time, but got %f", i, tt.expected[i], count/50000)
+				}
+			}
+		})
+	}
+
+	s := NewSource(8)
+	if s.WeightedChoice(nil) != -1 || s.WeightedChoice([]float64{0, -1}) != -1 {
+		t.Error("Expected -1 when nothing can be picked")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestShuffle(t *testing.T) {
+	shuffled := func(seed uint64) []int {
+		items := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		NewSource(seed).Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
+		return items
+	}
+
+	first := shuffled(12)
+	if !slices.Equal(first, shuffled(12)) {
+		t.Error("Expected the same seed to give the same order")
+	}
+	if slices.Equal(first, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Error("Expected the order to change")
+	}
+
+	sorted := slices.Clone(first)
+	slices.Sort(sorted)
+	if !slices.Equal(sorted, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("Expected the same items in a new order, but got %v", first)
+	}
+}
@@ -0,0 +1,123 @@
+package randomness
+
+// ------------------------------------------------------------------------------------------------
+const (
+	// PCG_DEFAULT_STREAM is the stream selector PCG uses when seeded through Seed. Like any
+	// stream, SeedStream turns it into the odd increment stream*2+1.
+	PCG_DEFAULT_STREAM = 1442695040888963407
+
+	pcgMultiplier    = 6364136223846793005
+	splitMixGamma    = 0x9e3779b97f4a7c15
+	xorshiftMultiply = 0x2545f4914f6cdd1d
+)
+
+// ------------------------------------------------------------------------------------------------
+// Generator produces raw 64 bit random values from a seed. The same seed always produces the
+// same values, which makes effects reproducible for tests and replays.
+type Generator interface {
+	Uint64() uint64
+	Seed(seed uint64)
+}
+
+// ------------------------------------------------------------------------------------------------
+// PCG is the 32 bit PCG-XSH-RR generator by Melissa O'Neill. It has small state, good
+// statistical quality and supports independent streams.
+type PCG struct {
+	state, increment uint64
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewPCG(seed uint64) *PCG {
+	p := &PCG{}
+	p.Seed(seed)
+	return p
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *PCG) Seed(seed uint64) {
+	p.SeedStream(seed, PCG_DEFAULT_STREAM)
+}
+
+// ------------------------------------------------------------------------------------------------
+// SeedStream seeds the generator on one of its streams. Generators with the same seed but
+// different streams produce unrelated values.
+func (p *PCG) SeedStream(seed, stream uint64) {
+	p.state = 0
+	p.increment = stream<<1 | 1
+	p.Uint32()
+	p.state += seed
+	p.Uint32()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (p *PCG) Uint32() uint32 {
+	old := p.state
+	p.state = old*pcgMultiplier + p.increment
+
+	shifted := uint32((old>>18 ^ old) >> 27)
+	rotation := uint32(old >> 59)
+	return shifted>>rotation | shifted<<(-rotation&31)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Uint64 joins two 32 bit outputs, the first one in the high bits.
+func (p *PCG) Uint64() uint64 {
+	return uint64(p.Uint32())<<32 | uint64(p.Uint32())
+}
+
+// ------------------------------------------------------------------------------------------------
+// Xorshift is the xorshift64* generator by Sebastiano Vigna, the fastest of the three.
+type Xorshift struct {
+	state uint64
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewXorshift(seed uint64) *Xorshift {
+	x := &Xorshift{}
+	x.Seed(seed)
+	return x
+}
+
+// ------------------------------------------------------------------------------------------------
+// Seed scrambles the seed with SplitMix, as the state must never be zero and similar seeds
+// should not give similar values.
+func (x *Xorshift) Seed(seed uint64) {
+	x.state = NewSplitMix(seed).Uint64()
+	if x.state == 0 {
+		x.state = splitMixGamma
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (x *Xorshift) Uint64() uint64 {
+	x.state ^= x.state >> 12
+	x.state ^= x.state << 25
+	x.state ^= x.state >> 27
+	return x.state * xorshiftMultiply
+}
+
+// ------------------------------------------------------------------------------------------------
+// SplitMix is the SplitMix64 generator. Any seed is fine, including zero, which makes it
+// handy for seeding other generators.
+type SplitMix struct {
+	state uint64
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewSplitMix(seed uint64) *SplitMix {
+	return &SplitMix{state: seed}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *SplitMix) Seed(seed uint64) {
+	s.state = seed
+}
+
+// ------------------------------------------------------------------------------------------------
+func (s *SplitMix) Uint64() uint64 {
+	s.state += splitMixGamma
+	z := s.state
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return z ^ z>>31
+}
@@ -23,3 +23,27 @@ func GenerateRandomNumbersUInt8(max, howMany int) []uint8 {
 
 	return nums
 }
+
+// ------------------------------------------------------------------------------------------------
+// GenerateRandomNumbersIntFrom is GenerateRandomNumbersInt drawing from the given source.
+func GenerateRandomNumbersIntFrom(source *Source, max, howMany int) []int {
+	nums := make([]int, howMany)
+
+	for pos := range howMany {
+		nums[pos] = source.IntN(max)
+	}
+
+	return nums
+}
+
+// ------------------------------------------------------------------------------------------------
+// GenerateRandomNumbersUInt8From is GenerateRandomNumbersUInt8 drawing from the given source.
+func GenerateRandomNumbersUInt8From(source *Source, max, howMany int) []uint8 {
+	nums := make([]uint8, howMany)
+
+	for pos := range howMany {
+		nums[pos] = uint8(source.IntN(max))
+	}
+
+	return nums
+}
@@ -0,0 +1,146 @@
+package randomness
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+// POISSON_DISC_ATTEMPTS is how many candidates Poisson-disc sampling tries around each point
+// before giving up on it, the value from Bridson's paper.
+const POISSON_DISC_ATTEMPTS = 30
+
+// ------------------------------------------------------------------------------------------------
+// Point is a pixel position. It has the same fields as canvas.Point, which this package
+// can't import, so convert with canvas.Point(p), or canvas.FromRandomPoints for a slice.
+type Point struct {
+	X, Y int
+}
+
+// ------------------------------------------------------------------------------------------------
+// InCircle returns a position inside the circle, evenly spread over its area.
+func (s *Source) InCircle(x, y, radius float64) (float64, float64) {
+	// the square root keeps positions from bunching up in the middle
+	distance := radius * math.Sqrt(s.Float64())
+	angle := s.Float64() * 2 * math.Pi
+	return x + math.Cos(angle)*distance, y + math.Sin(angle)*distance
+}
+
+// ------------------------------------------------------------------------------------------------
+// InRect returns a position inside the rectangle.
+func (s *Source) InRect(x, y, width, height float64) (float64, float64) {
+	return x + s.Float64()*width, y + s.Float64()*height
+}
+
+// ------------------------------------------------------------------------------------------------
+// InTriangle returns a position inside the triangle, evenly spread over its area.
+func (s *Source) InTriangle(x1, y1, x2, y2, x3, y3 float64) (float64, float64) {
+	u, v := s.Float64(), s.Float64()
+
+	// points beyond the diagonal of the parallelogram are folded back into the triangle
+	if u+v > 1 {
+		u, v = 1-u, 1-v
+	}
+	return x1 + u*(x2-x1) + v*(x3-x1), y1 + u*(y2-y1) + v*(y3-y1)
+}
+
+// ------------------------------------------------------------------------------------------------
+// PointInCircle returns a pixel inside the circle.
+func (s *Source) PointInCircle(centre Point, radius int) Point {
+	x, y := s.InCircle(float64(centre.X), float64(centre.Y), float64(radius))
+	return Point{X: int(math.Round(x)), Y: int(math.Round(y))}
+}
+
+// ------------------------------------------------------------------------------------------------
+// PointInRect returns a pixel of the width x height rectangle starting at x, y.
+func (s *Source) PointInRect(x, y, width, height int) Point {
+	return Point{X: x + s.IntN(max(1, width)), Y: y + s.IntN(max(1, height))}
+}
+
+// ------------------------------------------------------------------------------------------------
+// PointInTriangle returns a pixel inside the triangle.
+func (s *Source) PointInTriangle(a, b, c Point) Point {
+	x, y := s.InTriangle(float64(a.X), float64(a.Y), float64(b.X), float64(b.Y), float64(c.X), float64(c.Y))
+	return Point{X: int(math.Round(x)), Y: int(math.Round(y))}
+}
+
+// ------------------------------------------------------------------------------------------------
+// PoissonDisc scatters points over a width x height area so that no two are closer than
+// minDistance, while leaving no gap big enough for another one. It looks natural for stars,
+// trees or spawn spots, without the clumps of uniform points. This is Bridson's algorithm,
+// checking distances after rounding to pixels.
+func (s *Source) PoissonDisc(width, height int, minDistance float64) []Point {
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+	minDistance = max(1, minDistance)
+
+	// a cell this size can hold at most one point, so only nearby cells need checking
+	cellSize := minDistance / math.Sqrt2
+	columns := int(math.Ceil(float64(width)/cellSize)) + 1
+	rows := int(math.Ceil(float64(height)/cellSize)) + 1
+	grid := make([]int, columns*rows)
+	var points []Point
+	for i := range grid {
+		grid[i] = -1
+	}
+
+	cellOf := func(p Point) (int, int) {
+		return int(float64(p.X) / cellSize), int(float64(p.Y) / cellSize)
+	}
+
+	fits := func(p Point) bool {
+		if p.X < 0 || p.X >= width || p.Y < 0 || p.Y >= height {
+			return false
+		}
+
+		column, row := cellOf(p)
+		for y := max(0, row-2); y <= min(rows-1, row+2); y++ {
+			for x := max(0, column-2); x <= min(columns-1, column+2); x++ {
+				if index := grid[y*columns+x]; index >= 0 {
+					dx, dy := float64(points[index].X-p.X), float64(points[index].Y-p.Y)
+					if dx*dx+dy*dy < minDistance*minDistance {
+						return false
+					}
+				}
+			}
+		}
+		return true
+	}
+
+	add := func(p Point) {
+		column, row := cellOf(p)
+		grid[row*columns+column] = len(points)
+		points = append(points, p)
+	}
+
+	add(s.PointInRect(0, 0, width, height))
+	active := []int{0}
+
+	for len(active) > 0 {
+		i := s.IntN(len(active))
+		origin := points[active[i]]
+
+		found := false
+		for range POISSON_DISC_ATTEMPTS {
+			// candidates come from the ring between one and two minimum distances away
+			angle := s.Float64() * 2 * math.Pi
+			distance := minDistance * (1 + s.Float64())
+			candidate := Point{
+				X: origin.X + int(math.Round(math.Cos(angle)*distance)),
+				Y: origin.Y + int(math.Round(math.Sin(angle)*distance)),
+			}
+
+			if fits(candidate) {
+				add(candidate)
+				active = append(active, len(points)-1)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			active[i] = active[len(active)-1]
+			active = active[:len(active)-1]
+		}
+	}
+
+	return points
+}
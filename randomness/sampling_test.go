@@ -0,0 +1,94 @@
+package randomness
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestInShapes(t *testing.T) {
+	s := NewSource(30)
+
+	// barycentric signs tell whether a point is inside the triangle 0,0 10,0 0,10
+	insideTriangle := func(x, y float64) bool {
+		return x >= -1e-9 && y >= -1e-9 && x+y <= 10+1e-9
+	}
+
+	for range 10000 {
+		if x, y := s.InCircle(5, -5, 3); math.Hypot(x-5, y+5) > 3 {
+			t.Fatalf("Expected %f, %f inside the circle", x, y)
+		}
+		if x, y := s.InRect(1, 2, 3, 4); x < 1 || x >= 4 || y < 2 || y >= 6 {
+			t.Fatalf("Expected %f, %f inside the rectangle", x, y)
+		}
+		if x, y := s.InTriangle(0, 0, 10, 0, 0, 10); !insideTriangle(x, y) {
+			t.Fatalf("Expected %f, %f inside the triangle", x, y)
+		}
+
+		if p := s.PointInCircle(Point{X: 10, Y: 10}, 4); math.Hypot(float64(p.X-10), float64(p.Y-10)) > 4.71 {
+			t.Fatalf("Expected %v to round to a pixel of the circle", p)
+		}
+		if p := s.PointInRect(3, 4, 2, 2); p.X < 3 || p.X > 4 || p.Y < 4 || p.Y > 5 {
+			t.Fatalf("Expected %v inside the rectangle", p)
+		}
+		if p := s.PointInTriangle(Point{}, Point{X: 10}, Point{Y: 10}); !insideTriangle(float64(p.X), float64(p.Y)) {
+			t.Fatalf("Expected %v inside the triangle", p)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestInCircleIsEven(t *testing.T) {
+	// the inner circle of half the radius holds a quarter of the area
+	s := NewSource(31)
+	inner := 0
+	for range 40000 {
+		if x, y := s.InCircle(0, 0, 1); math.Hypot(x, y) < 0.5 {
+			inner++
+		}
+	}
+
+	if math.Abs(float64(inner)/40000-0.25) > 0.01 {
+		t.Errorf("Expected a quarter of the points in the inner circle, but got %f", float64(inner)/40000)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPoissonDisc(t *testing.T) {
+	tests := []struct {
+		name          string
+		width, height int
+		minDistance   float64
+	}{
+		{name: "square", width: 100, height: 100, minDistance: 8},
+		{name: "wide", width: 200, height: 30, minDistance: 5.5},
+		{name: "tiny", width: 3, height: 3, minDistance: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			points := NewSource(40).PoissonDisc(tt.width, tt.height, tt.minDistance)
+
+			for i, a := range points {
+				if a.X < 0 || a.X >= tt.width || a.Y < 0 || a.Y >= tt.height {
+					t.Fatalf("Expected %v inside the area", a)
+				}
+				for _, b := range points[i+1:] {
+					if d := math.Hypot(float64(a.X-b.X), float64(a.Y-b.Y)); d < tt.minDistance {
+						t.Fatalf("Expected %v and %v at least %f apart, but got %f", a, b, tt.minDistance, d)
+					}
+				}
+			}
+
+			// Bridson's sampling fills most of the space, roughly one point per 2r² of area
+			expected := float64(tt.width*tt.height) / (2 * tt.minDistance * tt.minDistance)
+			if float64(len(points)) < max(1, expected*0.6) {
+				t.Errorf("Expected about %f points, but got %d", expected, len(points))
+			}
+		})
+	}
+
+	if NewSource(1).PoissonDisc(0, 10, 2) != nil {
+		t.Error("Expected no points for an empty area")
+	}
+}
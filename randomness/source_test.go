@@ -0,0 +1,133 @@
+package randomness
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestGeneratorReferenceValues(t *testing.T) {
+	pcg := &PCG{}
+	pcg.SeedStream(42, 54)
+	for _, expected := range []uint32{0xa15c02b7, 0x7b47f409, 0xba1d3330, 0x83d2f293, 0xbfa4784b, 0xcbed606e} {
+		if got := pcg.Uint32(); got != expected {
+			t.Errorf("Expected PCG to give %#x, but got %#x", expected, got)
+		}
+	}
+
+	splitMix := NewSplitMix(1234567)
+	for _, expected := range []uint64{6457827717110365317, 3203168211198807973, 9817491932198370423} {
+		if got := splitMix.Uint64(); got != expected {
+			t.Errorf("Expected SplitMix to give %d, but got %d", expected, got)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestGeneratorsAreReproducible(t *testing.T) {
+	tests := []struct {
+		name      string
+		generator func(seed uint64) Generator
+	}{
+		{name: "pcg", generator: func(seed uint64) Generator { return NewPCG(seed) }},
+		{name: "xorshift", generator: func(seed uint64) Generator { return NewXorshift(seed) }},
+		{name: "splitmix", generator: func(seed uint64) Generator { return NewSplitMix(seed) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b, other := tt.generator(7), tt.generator(7), tt.generator(8)
+			first := a.Uint64()
+
+			if b.Uint64() != first {
+				t.Error("Expected the same seed to give the same values")
+			}
+			if other.Uint64() == first {
+				t.Error("Expected a different seed to give different values")
+			}
+
+			a.Uint64()
+			a.Seed(7)
+			if a.Uint64() != first {
+				t.Error("Expected Seed to restart the sequence")
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestXorshiftZeroSeed(t *testing.T) {
+	x := NewXorshift(0)
+	if x.Uint64() == 0 && x.Uint64() == 0 {
+		t.Error("Expected a zero seed to still produce values")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSourceRanges(t *testing.T) {
+	s := NewSourceFrom(NewXorshift(3))
+	counts := make([]int, 6)
+
+	for range 60000 {
+		n := s.IntN(6)
+		if n < 0 || n >= 6 {
+			t.Fatalf("Expected IntN(6) to be in range [0, 6), but got %d", n)
+		}
+		counts[n]++
+
+		if f := s.Float64(); f < 0 || f >= 1 {
+			t.Fatalf("Expected Float64 to be in range [0, 1), but got %v", f)
+		}
+		if f := s.Float32(); f < 0 || f >= 1 {
+			t.Fatalf("Expected Float32 to be in range [0, 1), but got %v", f)
+		}
+		if f := s.Between(-2, 3); f < -2 || f >= 3 {
+			t.Fatalf("Expected Between to be in range [-2, 3), but got %v", f)
+		}
+	}
+
+	for i, count := range counts {
+		if math.Abs(float64(count)-10000) > 500 {
+			t.Errorf("Expected about 10000 of %d, but got %d", i, count)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSourceWorksWithMathRand(t *testing.T) {
+	a := rand.New(NewSource(5))
+	b := rand.New(NewSource(5))
+
+	for range 10 {
+		if a.NormFloat64() != b.NormFloat64() {
+			t.Fatal("Expected math/rand/v2 to give the same values for the same seed")
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestSourceIntNPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected a panic for IntN(0)")
+		}
+	}()
+	NewSource(1).IntN(0)
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestGenerateRandomNumbersFrom(t *testing.T) {
+	ints := GenerateRandomNumbersIntFrom(NewSource(9), 100, 50)
+	again := GenerateRandomNumbersIntFrom(NewSource(9), 100, 50)
+	bytes := GenerateRandomNumbersUInt8From(NewSource(9), 200, 50)
+
+	for i := range ints {
+		if ints[i] != again[i] {
+			t.Fatalf("Expected the same numbers for the same seed, differing at %d", i)
+		}
+		if ints[i] < 0 || ints[i] >= 100 || bytes[i] >= 200 {
+			t.Errorf("Expected numbers in range, but got %d and %d", ints[i], bytes[i])
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package terminal
+
+import (
+	"os"
+	"strings"
+)
+
+// ------------------------------------------------------------------------------------------------
+// ColourMode selects how colours are written to the terminal.
+type ColourMode int
+
+const (
+	// TrueColour uses 24 bit colour sequences.
+	TrueColour ColourMode = iota
+	// Colour256 uses the xterm 256 colour palette.
+	Colour256
+	// Colour16 uses the basic 16 ANSI colours.
+	Colour16
+)
+
+// ------------------------------------------------------------------------------------------------
+// DetectColourMode guesses the best colour mode from the COLORTERM and TERM environment
+// variables.
+func DetectColourMode() ColourMode {
+	colourTerm := strings.ToLower(os.Getenv("COLORTERM"))
+	if colourTerm == "truecolor" || colourTerm == "24bit" {
+		return TrueColour
+	}
+
+	if strings.Contains(os.Getenv("TERM"), "256") {
+		return Colour256
+	}
+
+	return Colour16
+}
+
+// ------------------------------------------------------------------------------------------------
+// cubeLevels are the component values used by the 6x6x6 colour cube of the 256 colour palette.
+var cubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// ------------------------------------------------------------------------------------------------
+// ansiColours are the usual xterm values for the 16 ANSI colours.
+var ansiColours = [16][3]int{
+	{0, 0, 0}, {205, 0, 0}, {0, 205, 0}, {205, 205, 0},
+	{0, 0, 238}, {205, 0, 205}, {0, 205, 205}, {229, 229, 229},
+	{127, 127, 127}, {255, 0, 0}, {0, 255, 0}, {255, 255, 0},
+	{92, 92, 255}, {255, 0, 255}, {0, 255, 255}, {255, 255, 255},
+}
+
+// ------------------------------------------------------------------------------------------------
+// To256 returns the index of the closest colour in the xterm 256 colour palette, choosing
+// between the colour cube and the greyscale ramp.
+func To256(r, g, b uint8) int {
+	ri, gi, bi := nearestCubeLevel(int(r)), nearestCubeLevel(int(g)), nearestCubeLevel(int(b))
+	cubeIndex := 16 + 36*ri + 6*gi + bi
+	cubeDistance := distanceSquared(int(r), int(g), int(b), cubeLevels[ri], cubeLevels[gi], cubeLevels[bi])
+
+	// the greyscale ramp runs from 8 to 238 in steps of 10
+	average := (int(r) + int(g) + int(b)) / 3
+	greyStep := min(23, max(0, (average-3)/10))
+	grey := 8 + greyStep*10
+	greyDistance := distanceSquared(int(r), int(g), int(b), grey, grey, grey)
+
+	if greyDistance < cubeDistance {
+		return 232 + greyStep
+	}
+	return cubeIndex
+}
+
+// ------------------------------------------------------------------------------------------------
+// To16 returns the index of the closest of the 16 ANSI colours.
+func To16(r, g, b uint8) int {
+	best, bestDistance := 0, -1
+
+	for i, c := range ansiColours {
+		distance := distanceSquared(int(r), int(g), int(b), c[0], c[1], c[2])
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = i, distance
+		}
+	}
+
+	return best
+}
+
+// ------------------------------------------------------------------------------------------------
+func nearestCubeLevel(value int) int {
+	best := 0
+	for i, level := range cubeLevels {
+		if abs(value-level) < abs(value-cubeLevels[best]) {
+			best = i
+		}
+	}
+	return best
+}
+
+// ------------------------------------------------------------------------------------------------
+func distanceSquared(r1, g1, b1, r2, g2, b2 int) int {
+	dr, dg, db := r1-r2, g1-g2, b1-b2
+	return dr*dr + dg*dg + db*db
+}
+
+// ------------------------------------------------------------------------------------------------
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,113 @@
+package terminal
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestQuantizeKeepsFewColours(t *testing.T) {
+	pb := buffers.NewPixelBuffer(4, 4, make([]uint8, 4*4*buffers.RGBABytesPerPixel))
+	red := colour.NewColour(255, 0, 0, 255)
+	blue := colour.NewColour(0, 0, 255, 255)
+	pb.ColourPutPixel(0, 0, red)
+	pb.ColourPutPixel(3, 3, blue)
+
+	palette, indices := Quantize(pb, 16)
+
+	if len(palette) != 3 {
+		t.Fatalf("Expected black, red and blue in the palette, got %v", palette)
+	}
+
+	if palette[indices[0]] != red || palette[indices[15]] != blue || palette[indices[5]] != colour.NewColourBlack() {
+		t.Errorf("Expected pixels to map to their exact colours, got %v", palette)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestQuantizeLimitsPalette(t *testing.T) {
+	pb := buffers.NewPixelBuffer(64, 64, make([]uint8, 64*64*buffers.RGBABytesPerPixel))
+	for y := range 64 {
+		for x := range 64 {
+			pb.ColourPutPixel(x, y, colour.NewColour(uint8(x*4), uint8(y*4), uint8((x+y)*2), 255))
+		}
+	}
+
+	palette, indices := Quantize(pb, 8)
+
+	if len(palette) != 8 {
+		t.Errorf("Expected 8 palette entries, got %d", len(palette))
+	}
+
+	for _, index := range indices {
+		if int(index) >= len(palette) {
+			t.Fatalf("Palette index %d out of range", index)
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEncodeSixel(t *testing.T) {
+	pb := buffers.NewPixelBuffer(5, 7, make([]uint8, 5*7*buffers.RGBABytesPerPixel))
+	white := colour.NewColourWhite()
+	for x := range 5 {
+		for y := range 6 {
+			pb.ColourPutPixel(x, y, white)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := EncodeSixel(&out, pb, 256); err != nil {
+		t.Fatal(err)
+	}
+
+	text := out.String()
+	if !strings.HasPrefix(text, "\x1bPq\"1;1;5;7#0;2;0;0;0#1;2;100;100;100") {
+		t.Errorf("Unexpected sixel header %q", text)
+	}
+
+	// the first band is all white, the second has one black row
+	if !strings.Contains(text, "#1!5~-#0!5@-") {
+		t.Errorf("Unexpected sixel bands %q", text)
+	}
+
+	if !strings.HasSuffix(text, "\x1b\\") {
+		t.Error("Expected the sixel sequence to be terminated")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestEncodeKittyChunks(t *testing.T) {
+	// 40x40 RGB pixels need 6400 base64 characters, which takes two chunks
+	pb := buffers.NewPixelBuffer(40, 40, make([]uint8, 40*40*buffers.RGBABytesPerPixel))
+	pb.ColourPutPixel(0, 0, colour.NewColour(1, 2, 3, 255))
+
+	var out bytes.Buffer
+	if err := EncodeKitty(&out, pb); err != nil {
+		t.Fatal(err)
+	}
+
+	chunks := strings.Split(strings.TrimSuffix(out.String(), "\x1b\\"), "\x1b\\")
+	if len(chunks) != 2 {
+		t.Fatalf("Expected 2 chunks, got %d", len(chunks))
+	}
+
+	if !strings.HasPrefix(chunks[0], "\x1b_Ga=T,f=24,q=2,s=40,v=40,m=1;") || !strings.HasPrefix(chunks[1], "\x1b_Gm=0;") {
+		t.Errorf("Unexpected chunk headers %q and %q", chunks[0][:40], chunks[1][:10])
+	}
+
+	payload := chunks[0][strings.Index(chunks[0], ";")+1:] + chunks[1][strings.Index(chunks[1], ";")+1:]
+	rgb, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rgb) != 40*40*3 || rgb[0] != 1 || rgb[1] != 2 || rgb[2] != 3 {
+		t.Errorf("Unexpected decoded pixels, %d bytes starting with %v", len(rgb), rgb[:3])
+	}
+}
@@ -0,0 +1,61 @@
+package terminal
+
+import (
+	"bufio"
+	"encoding/base64"
+	"io"
+	"strconv"
+
+	"github.com/ewaldhorn/gogi/buffers"
+)
+
+// ------------------------------------------------------------------------------------------------
+// KITTY_CHUNK_SIZE is the largest base64 payload the Kitty protocol allows per escape sequence.
+const KITTY_CHUNK_SIZE = 4096
+
+// ------------------------------------------------------------------------------------------------
+// EncodeKitty writes src as an image using the Kitty graphics protocol, transmitting and
+// displaying it at the cursor position. The pixels are sent as 24 bit RGB, ignoring alpha,
+// and the terminal is asked not to send any responses.
+func EncodeKitty(w io.Writer, src buffers.PixelSource) error {
+	width, height := src.Width(), src.Height()
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	rgb := make([]byte, 0, width*height*3)
+	for y := range height {
+		for x := range width {
+			c := src.GetPixel(x, y)
+			rgb = append(rgb, c.R, c.G, c.B)
+		}
+	}
+
+	payload := base64.StdEncoding.EncodeToString(rgb)
+	out := bufio.NewWriter(w)
+
+	for start := 0; start < len(payload); start += KITTY_CHUNK_SIZE {
+		end := min(start+KITTY_CHUNK_SIZE, len(payload))
+
+		out.WriteString("\x1b_G")
+		if start == 0 {
+			out.WriteString("a=T,f=24,q=2,s=")
+			out.WriteString(strconv.Itoa(width))
+			out.WriteString(",v=")
+			out.WriteString(strconv.Itoa(height))
+			out.WriteByte(',')
+		}
+
+		// m=1 tells the terminal more chunks follow
+		if end < len(payload) {
+			out.WriteString("m=1;")
+		} else {
+			out.WriteString("m=0;")
+		}
+
+		out.WriteString(payload[start:end])
+		out.WriteString("\x1b\\")
+	}
+
+	return out.Flush()
+}
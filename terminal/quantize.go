@@ -0,0 +1,151 @@
+package terminal
+
+import (
+	"slices"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// colourCount is a colour in the histogram, reduced to 5 bits per component.
+type colourCount struct {
+	key   uint16
+	count int
+}
+
+// ------------------------------------------------------------------------------------------------
+// Quantize reduces the colours of src to a palette of at most maxColours entries using median
+// cut, returning the palette and the palette index of every pixel, row by row. Alpha is
+// ignored.
+func Quantize(src buffers.PixelSource, maxColours int) ([]colour.Colour, []uint8) {
+	maxColours = max(1, min(256, maxColours))
+	width, height := src.Width(), src.Height()
+
+	// build a histogram of 15 bit colours, which keeps the boxes small and is plenty for
+	// terminal previews
+	counts := make(map[uint16]int)
+	keys := make([]uint16, width*height)
+	for y := range height {
+		for x := range width {
+			c := src.GetPixel(x, y)
+			key := uint16(c.R>>3)<<10 | uint16(c.G>>3)<<5 | uint16(c.B>>3)
+			keys[y*width+x] = key
+			counts[key]++
+		}
+	}
+
+	histogram := make([]colourCount, 0, len(counts))
+	for key, count := range counts {
+		histogram = append(histogram, colourCount{key: key, count: count})
+	}
+	// map iteration order is random, sort so the palette is the same every time
+	slices.SortFunc(histogram, func(a, b colourCount) int { return int(a.key) - int(b.key) })
+
+	boxes := medianCut(histogram, maxColours)
+
+	palette := make([]colour.Colour, len(boxes))
+	lookup := make(map[uint16]uint8, len(histogram))
+	for i, box := range boxes {
+		palette[i] = averageColour(box)
+		for _, entry := range box {
+			lookup[entry.key] = uint8(i)
+		}
+	}
+
+	indices := make([]uint8, len(keys))
+	for i, key := range keys {
+		indices[i] = lookup[key]
+	}
+
+	return palette, indices
+}
+
+// ------------------------------------------------------------------------------------------------
+// medianCut keeps splitting the box with the widest colour range at its median until there
+// are enough boxes or nothing can be split any more.
+func medianCut(histogram []colourCount, maxBoxes int) [][]colourCount {
+	if len(histogram) == 0 {
+		return nil
+	}
+
+	boxes := [][]colourCount{histogram}
+
+	for len(boxes) < maxBoxes {
+		widest, widestRange, splitChannel := -1, 0, 0
+		for i, box := range boxes {
+			if len(box) < 2 {
+				continue
+			}
+			channel, spread := widestChannel(box)
+			if spread > widestRange {
+				widest, widestRange, splitChannel = i, spread, channel
+			}
+		}
+
+		if widest < 0 {
+			break
+		}
+
+		box := boxes[widest]
+		shift := uint(10 - splitChannel*5)
+		slices.SortFunc(box, func(a, b colourCount) int {
+			return int(a.key>>shift&31) - int(b.key>>shift&31)
+		})
+
+		// split where half the pixels are on either side
+		total := 0
+		for _, entry := range box {
+			total += entry.count
+		}
+		split, seen := 1, 0
+		for i, entry := range box[:len(box)-1] {
+			seen += entry.count
+			if seen*2 >= total {
+				split = i + 1
+				break
+			}
+		}
+
+		boxes[widest] = box[:split:split]
+		boxes = append(boxes, box[split:])
+	}
+
+	return boxes
+}
+
+// ------------------------------------------------------------------------------------------------
+// widestChannel returns the channel (0 red, 1 green, 2 blue) with the largest range in the box.
+func widestChannel(box []colourCount) (channel, spread int) {
+	for c := range 3 {
+		shift := uint(10 - c*5)
+		low, high := 31, 0
+		for _, entry := range box {
+			value := int(entry.key >> shift & 31)
+			low, high = min(low, value), max(high, value)
+		}
+		if high-low > spread {
+			channel, spread = c, high-low
+		}
+	}
+	return channel, spread
+}
+
+// ------------------------------------------------------------------------------------------------
+// averageColour returns the pixel weighted average colour of a box.
+func averageColour(box []colourCount) colour.Colour {
+	var r, g, b, total int
+	for _, entry := range box {
+		r += int(entry.key>>10&31) * entry.count
+		g += int(entry.key>>5&31) * entry.count
+		b += int(entry.key&31) * entry.count
+		total += entry.count
+	}
+
+	// scale 5 bit values back to 8 bits
+	expand := func(sum int) uint8 {
+		return uint8(sum * 255 / (total * 31))
+	}
+
+	return colour.NewColour(expand(r), expand(g), expand(b), colour.MAX_COLOUR_VALUE)
+}
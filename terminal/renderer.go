@@ -0,0 +1,213 @@
+// Package terminal renders pixel buffers as ANSI escape sequences, drawing two pixels per
+// character cell with the upper half block character. It falls back to the 256 and 16 colour
+// palettes on terminals without truecolour support, and only redraws cells that changed
+// since the previous frame. For terminals that show real images there are Sixel and Kitty
+// graphics protocol encoders as well.
+package terminal
+
+import (
+	"bytes"
+	"io"
+	"strconv"
+
+	"github.com/ewaldhorn/gogi/buffers"
+)
+
+// ------------------------------------------------------------------------------------------------
+const (
+	escape         = "\x1b["
+	upperHalfBlock = "▀"
+
+	// noColour never matches an encoded colour, forcing the next colour to be written
+	noColour = ^uint32(0)
+)
+
+// ------------------------------------------------------------------------------------------------
+// cell holds the encoded colours of the top and bottom pixel of a character cell.
+type cell struct {
+	top, bottom uint32
+}
+
+// ------------------------------------------------------------------------------------------------
+type Renderer struct {
+	mode          ColourMode
+	columns, rows int
+
+	previous                []cell
+	frameColumns, frameRows int
+	out                     bytes.Buffer
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewRenderer creates a renderer drawing one column per pixel and one row per two pixels.
+// Use SetSize to scale the output to a fixed number of character cells.
+func NewRenderer(mode ColourMode) *Renderer {
+	return &Renderer{mode: mode}
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetSize scales the output to fit columns x rows character cells. Zero for either uses the
+// native size of the source.
+func (r *Renderer) SetSize(columns, rows int) {
+	r.columns, r.rows = columns, rows
+}
+
+// ------------------------------------------------------------------------------------------------
+func (r *Renderer) SetColourMode(mode ColourMode) {
+	if r.mode != mode {
+		r.mode = mode
+		r.Reset()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Reset forgets the previous frame, so the next Render redraws every cell. Call it after
+// anything else wrote to the terminal.
+func (r *Renderer) Reset() {
+	r.previous = nil
+}
+
+// ------------------------------------------------------------------------------------------------
+// Render writes the escape sequences needed to show src. The first frame, and any frame
+// after a size change or Reset, clears the screen and draws everything. Later frames only
+// update the cells that changed.
+func (r *Renderer) Render(w io.Writer, src buffers.PixelSource) error {
+	width, height := src.Width(), src.Height()
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	columns, rows := r.columns, r.rows
+	if columns <= 0 || rows <= 0 {
+		columns, rows = width, (height+1)/2
+	}
+
+	if columns != r.frameColumns || rows != r.frameRows {
+		r.frameColumns, r.frameRows = columns, rows
+		r.previous = nil
+	}
+
+	full := r.previous == nil
+	if full {
+		r.previous = make([]cell, columns*rows)
+	}
+
+	r.out.Reset()
+	if full {
+		r.out.WriteString(escape + "0m" + escape + "2J")
+	}
+
+	cursorRow, cursorColumn := -1, -1
+	foreground, background := noColour, noColour
+
+	for row := range rows {
+		topY := row * 2 * height / (rows * 2)
+		bottomY := (row*2 + 1) * height / (rows * 2)
+
+		for column := range columns {
+			x := column * width / columns
+			current := cell{
+				top:    r.encode(src, x, topY),
+				bottom: r.encode(src, x, bottomY),
+			}
+
+			index := row*columns + column
+			if !full && r.previous[index] == current {
+				continue
+			}
+			r.previous[index] = current
+
+			if cursorRow != row || cursorColumn != column {
+				r.moveTo(row, column)
+			}
+
+			if current.top != foreground {
+				r.writeColour(true, current.top)
+				foreground = current.top
+			}
+			if current.bottom != background {
+				r.writeColour(false, current.bottom)
+				background = current.bottom
+			}
+
+			r.out.WriteString(upperHalfBlock)
+			cursorRow, cursorColumn = row, column+1
+		}
+	}
+
+	if r.out.Len() == 0 {
+		return nil
+	}
+
+	r.out.WriteString(escape + "0m")
+	_, err := w.Write(r.out.Bytes())
+	return err
+}
+
+// ------------------------------------------------------------------------------------------------
+// encode converts the pixel at x, y to the colour value used by the current mode. Pixels
+// below the source, for odd heights, are black.
+func (r *Renderer) encode(src buffers.PixelSource, x, y int) uint32 {
+	if y >= src.Height() {
+		return 0
+	}
+
+	c := src.GetPixel(x, y)
+
+	switch r.mode {
+	case Colour256:
+		return uint32(To256(c.R, c.G, c.B))
+	case Colour16:
+		return uint32(To16(c.R, c.G, c.B))
+	default:
+		return uint32(c.R)<<16 | uint32(c.G)<<8 | uint32(c.B)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (r *Renderer) moveTo(row, column int) {
+	r.out.WriteString(escape)
+	r.out.WriteString(strconv.Itoa(row + 1))
+	r.out.WriteByte(';')
+	r.out.WriteString(strconv.Itoa(column + 1))
+	r.out.WriteByte('H')
+}
+
+// ------------------------------------------------------------------------------------------------
+// writeColour writes the SGR sequence selecting an encoded colour as the foreground or
+// background colour.
+func (r *Renderer) writeColour(foreground bool, value uint32) {
+	r.out.WriteString(escape)
+
+	switch r.mode {
+	case Colour256:
+		if foreground {
+			r.out.WriteString("38;5;")
+		} else {
+			r.out.WriteString("48;5;")
+		}
+		r.out.WriteString(strconv.Itoa(int(value)))
+	case Colour16:
+		code := 30 + int(value)
+		if value >= 8 {
+			code = 90 + int(value) - 8
+		}
+		if !foreground {
+			code += 10
+		}
+		r.out.WriteString(strconv.Itoa(code))
+	default:
+		if foreground {
+			r.out.WriteString("38;2;")
+		} else {
+			r.out.WriteString("48;2;")
+		}
+		r.out.WriteString(strconv.Itoa(int(value >> 16 & 0xff)))
+		r.out.WriteByte(';')
+		r.out.WriteString(strconv.Itoa(int(value >> 8 & 0xff)))
+		r.out.WriteByte(';')
+		r.out.WriteString(strconv.Itoa(int(value & 0xff)))
+	}
+
+	r.out.WriteByte('m')
+}
@@ -0,0 +1,147 @@
+package terminal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestRenderFullFrame(t *testing.T) {
+	pb := buffers.NewPixelBuffer(3, 4, make([]uint8, 3*4*buffers.RGBABytesPerPixel))
+	pb.ColourPutPixel(0, 0, colour.NewColour(255, 0, 0, 255))
+	pb.ColourPutPixel(0, 1, colour.NewColour(0, 255, 0, 255))
+
+	var out bytes.Buffer
+	r := NewRenderer(TrueColour)
+
+	if err := r.Render(&out, pb); err != nil {
+		t.Fatal(err)
+	}
+
+	text := out.String()
+	if !strings.HasPrefix(text, "\x1b[0m\x1b[2J\x1b[1;1H\x1b[38;2;255;0;0m\x1b[48;2;0;255;0m▀") {
+		t.Errorf("Unexpected start of frame %q", text)
+	}
+
+	if strings.Count(text, upperHalfBlock) != 6 {
+		t.Errorf("Expected 6 cells for a 3x4 buffer, got %d", strings.Count(text, upperHalfBlock))
+	}
+
+	// the remaining black cells share their colours, so they only need one colour change
+	if strings.Count(text, "38;2;0;0;0m") != 1 {
+		t.Errorf("Expected repeated colours not to be written again, got %q", text)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRenderOnlyChangedCells(t *testing.T) {
+	pb := buffers.NewPixelBuffer(4, 4, make([]uint8, 4*4*buffers.RGBABytesPerPixel))
+	r := NewRenderer(TrueColour)
+
+	var out bytes.Buffer
+	r.Render(&out, pb)
+
+	out.Reset()
+	r.Render(&out, pb)
+	if out.Len() != 0 {
+		t.Errorf("Expected nothing to be written for an unchanged frame, got %q", out.String())
+	}
+
+	pb.ColourPutPixel(2, 3, colour.NewColourWhite())
+	r.Render(&out, pb)
+
+	text := out.String()
+	if text != "\x1b[2;3H\x1b[38;2;0;0;0m\x1b[48;2;255;255;255m▀\x1b[0m" {
+		t.Errorf("Expected a single cell update, got %q", text)
+	}
+
+	out.Reset()
+	r.Reset()
+	r.Render(&out, pb)
+	if strings.Count(out.String(), upperHalfBlock) != 8 {
+		t.Error("Expected a full redraw after Reset")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRenderFallbackModes(t *testing.T) {
+	pb := buffers.NewPixelBuffer(1, 2, make([]uint8, 1*2*buffers.RGBABytesPerPixel))
+	pb.ColourPutPixel(0, 0, colour.NewColour(255, 0, 0, 255))
+	pb.ColourPutPixel(0, 1, colour.NewColourWhite())
+
+	var out bytes.Buffer
+	NewRenderer(Colour256).Render(&out, pb)
+	if !strings.Contains(out.String(), "\x1b[38;5;196m\x1b[48;5;231m▀") {
+		t.Errorf("Unexpected 256 colour output %q", out.String())
+	}
+
+	out.Reset()
+	NewRenderer(Colour16).Render(&out, pb)
+	if !strings.Contains(out.String(), "\x1b[91m\x1b[107m▀") {
+		t.Errorf("Unexpected 16 colour output %q", out.String())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRenderScaled(t *testing.T) {
+	pb := buffers.NewPixelBuffer(100, 100, make([]uint8, 100*100*buffers.RGBABytesPerPixel))
+	r := NewRenderer(TrueColour)
+	r.SetSize(10, 5)
+
+	var out bytes.Buffer
+	r.Render(&out, pb)
+
+	if strings.Count(out.String(), upperHalfBlock) != 50 {
+		t.Errorf("Expected 50 cells, got %d", strings.Count(out.String(), upperHalfBlock))
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestColourConversions(t *testing.T) {
+	tests := []struct {
+		r, g, b uint8
+		want256 int
+		want16  int
+		name    string
+	}{
+		{r: 0, g: 0, b: 0, want256: 16, want16: 0, name: "Black"},
+		{r: 255, g: 255, b: 255, want256: 231, want16: 15, name: "White"},
+		{r: 255, g: 0, b: 0, want256: 196, want16: 9, name: "Red"},
+		{r: 128, g: 128, b: 128, want256: 244, want16: 8, name: "Grey"},
+		{r: 0, g: 0, b: 200, want256: 20, want16: 4, name: "Blue"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := To256(tt.r, tt.g, tt.b); got != tt.want256 {
+				t.Errorf("To256() = %d; want %d", got, tt.want256)
+			}
+			if got := To16(tt.r, tt.g, tt.b); got != tt.want16 {
+				t.Errorf("To16() = %d; want %d", got, tt.want16)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestDetectColourMode(t *testing.T) {
+	t.Setenv("COLORTERM", "truecolor")
+	if DetectColourMode() != TrueColour {
+		t.Error("Expected truecolour")
+	}
+
+	t.Setenv("COLORTERM", "")
+	t.Setenv("TERM", "xterm-256color")
+	if DetectColourMode() != Colour256 {
+		t.Error("Expected 256 colours")
+	}
+
+	t.Setenv("TERM", "vt100")
+	if DetectColourMode() != Colour16 {
+		t.Error("Expected 16 colours")
+	}
+}
@@ -0,0 +1,115 @@
+package terminal
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+
+	"github.com/ewaldhorn/gogi/buffers"
+)
+
+// ------------------------------------------------------------------------------------------------
+// MAX_SIXEL_COLOURS is the palette size most Sixel terminals support.
+const MAX_SIXEL_COLOURS = 256
+
+// ------------------------------------------------------------------------------------------------
+// EncodeSixel writes src as a Sixel image, quantizing it to at most maxColours colours.
+// Alpha is ignored.
+func EncodeSixel(w io.Writer, src buffers.PixelSource, maxColours int) error {
+	width, height := src.Width(), src.Height()
+	if width <= 0 || height <= 0 {
+		return nil
+	}
+
+	palette, indices := Quantize(src, min(maxColours, MAX_SIXEL_COLOURS))
+	out := bufio.NewWriter(w)
+
+	// start the sixel sequence, with a 1:1 pixel aspect ratio and the image size
+	out.WriteString("\x1bPq\"1;1;")
+	out.WriteString(strconv.Itoa(width))
+	out.WriteByte(';')
+	out.WriteString(strconv.Itoa(height))
+
+	// palette entries use percentages rather than byte values
+	for i, c := range palette {
+		out.WriteByte('#')
+		out.WriteString(strconv.Itoa(i))
+		out.WriteString(";2;")
+		out.WriteString(strconv.Itoa(int(c.R) * 100 / 255))
+		out.WriteByte(';')
+		out.WriteString(strconv.Itoa(int(c.G) * 100 / 255))
+		out.WriteByte(';')
+		out.WriteString(strconv.Itoa(int(c.B) * 100 / 255))
+	}
+
+	used := make([]bool, len(palette))
+	bits := make([]byte, width)
+
+	// every sixel character covers a column of six pixels
+	for bandY := 0; bandY < height; bandY += 6 {
+		bandHeight := min(6, height-bandY)
+
+		clear(used)
+		for y := bandY; y < bandY+bandHeight; y++ {
+			for _, index := range indices[y*width : (y+1)*width] {
+				used[index] = true
+			}
+		}
+
+		first := true
+		for index, isUsed := range used {
+			if !isUsed {
+				continue
+			}
+
+			clear(bits)
+			for dy := range bandHeight {
+				row := indices[(bandY+dy)*width : (bandY+dy+1)*width]
+				for x, pixelIndex := range row {
+					if int(pixelIndex) == index {
+						bits[x] |= 1 << uint(dy)
+					}
+				}
+			}
+
+			// go back to the start of the band for every colour after the first
+			if !first {
+				out.WriteByte('$')
+			}
+			first = false
+
+			out.WriteByte('#')
+			out.WriteString(strconv.Itoa(index))
+			writeSixelRuns(out, bits)
+		}
+
+		out.WriteByte('-')
+	}
+
+	out.WriteString("\x1b\\")
+	return out.Flush()
+}
+
+// ------------------------------------------------------------------------------------------------
+// writeSixelRuns writes a row of sixels, using run length encoding for repeats.
+func writeSixelRuns(out *bufio.Writer, bits []byte) {
+	for x := 0; x < len(bits); {
+		run := 1
+		for x+run < len(bits) && bits[x+run] == bits[x] {
+			run++
+		}
+
+		char := byte(63 + bits[x])
+		if run > 3 {
+			out.WriteByte('!')
+			out.WriteString(strconv.Itoa(run))
+			out.WriteByte(char)
+		} else {
+			for range run {
+				out.WriteByte(char)
+			}
+		}
+
+		x += run
+	}
+}
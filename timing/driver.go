@@ -0,0 +1,40 @@
+package timing
+
+import "time"
+
+// The browser drives a Loop from requestAnimationFrame through the wasmhost package. The
+// drivers below cover native builds.
+
+// ------------------------------------------------------------------------------------------------
+// RunHeadless advances the loop for the given number of frames, each exactly frameTime
+// seconds long. Runs as fast as possible and is fully deterministic, which suits tests,
+// golden images and recording replays.
+func RunHeadless(l *Loop, frames int, frameTime float64) {
+	for range frames {
+		l.Advance(frameTime)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// RunRealtime advances the loop using the wall clock, aiming for frameRate frames per second,
+// until stop is closed.
+func RunRealtime(l *Loop, frameRate float64, stop <-chan struct{}) {
+	if frameRate <= 0 {
+		frameRate = 1 / DEFAULT_STEP
+	}
+
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / frameRate))
+	defer ticker.Stop()
+
+	last := time.Now()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			l.Advance(now.Sub(last).Seconds())
+			last = now
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Package timing provides a fixed timestep game loop. The host reports how much real time
+// passed and the loop runs as many fixed updates as needed, so animation speed no longer
+// depends on the frame rate. Rendering gets an interpolation factor to smooth out the
+// difference between the update and frame rates.
+package timing
+
+// ------------------------------------------------------------------------------------------------
+const (
+	// DEFAULT_STEP is the fixed update interval in seconds.
+	DEFAULT_STEP = 1.0 / 60.0
+	// MAX_FRAME_TIME caps the real time handled in one frame, so a long stall (a hidden
+	// browser tab, a debugger breakpoint) doesn't trigger a burst of catch-up updates.
+	MAX_FRAME_TIME = 0.25
+)
+
+// ------------------------------------------------------------------------------------------------
+// Game is driven by a Loop.
+type Game interface {
+	// FixedUpdate advances the simulation by exactly step seconds of game time.
+	FixedUpdate(step float64)
+	// Render draws the current state. alpha, in the range [0, 1), is how far the loop is
+	// between the previous and the next fixed update, for interpolating positions.
+	Render(alpha float64)
+}
+
+// ------------------------------------------------------------------------------------------------
+type Loop struct {
+	game         Game
+	step         float64
+	maxFrameTime float64
+	timeScale    float64
+	paused       bool
+
+	accumulator float64
+	delta       float64
+	rawDelta    float64
+	elapsed     float64
+	ticks       uint64
+	frames      uint64
+}
+
+// ------------------------------------------------------------------------------------------------
+// NewLoop creates a loop running fixed updates every step seconds. A step of zero or less
+// uses DEFAULT_STEP.
+func NewLoop(game Game, step float64) *Loop {
+	if step <= 0 {
+		step = DEFAULT_STEP
+	}
+
+	return &Loop{
+		game:         game,
+		step:         step,
+		maxFrameTime: MAX_FRAME_TIME,
+		timeScale:    1.0,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Advance is called by the driver once per frame with the real time, in seconds, since the
+// previous frame. It runs the fixed updates that are due, renders once and returns the
+// number of fixed updates that ran. Negative and NaN times count as no time at all.
+func (l *Loop) Advance(dt float64) int {
+	l.frames++
+
+	// a NaN would stick in the accumulator and stop the updates for good
+	if !(dt > 0) {
+		dt = 0
+	}
+	l.rawDelta = dt
+	l.delta = 0
+
+	updates := 0
+
+	if !l.paused {
+		l.delta = min(l.rawDelta, l.maxFrameTime) * l.timeScale
+		l.accumulator += l.delta
+
+		for l.accumulator >= l.step {
+			l.game.FixedUpdate(l.step)
+			l.accumulator -= l.step
+			l.elapsed += l.step
+			l.ticks++
+			updates++
+		}
+	}
+
+	l.game.Render(l.Alpha())
+	return updates
+}
+
+// ------------------------------------------------------------------------------------------------
+// Alpha returns how far the loop is between fixed updates, in the range [0, 1).
+func (l *Loop) Alpha() float64 {
+	return l.accumulator / l.step
+}
+
+// ------------------------------------------------------------------------------------------------
+// Delta returns the game time handled by the last frame, after pausing, capping and scaling.
+func (l *Loop) Delta() float64 {
+	return l.delta
+}
+
+// ------------------------------------------------------------------------------------------------
+// RawDelta returns the real time reported for the last frame.
+func (l *Loop) RawDelta() float64 {
+	return l.rawDelta
+}
+
+// ------------------------------------------------------------------------------------------------
+// Elapsed returns the total game time simulated by fixed updates.
+func (l *Loop) Elapsed() float64 {
+	return l.elapsed
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Loop) Step() float64 {
+	return l.step
+}
+
+// ------------------------------------------------------------------------------------------------
+// Ticks returns the number of fixed updates run so far.
+func (l *Loop) Ticks() uint64 {
+	return l.ticks
+}
+
+// ------------------------------------------------------------------------------------------------
+// Frames returns the number of frames rendered so far.
+func (l *Loop) Frames() uint64 {
+	return l.frames
+}
+
+// ------------------------------------------------------------------------------------------------
+// Pause stops fixed updates. Frames keep rendering, so the game can still draw a pause screen.
+func (l *Loop) Pause() {
+	l.paused = true
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Loop) Resume() {
+	l.paused = false
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Loop) IsPaused() bool {
+	return l.paused
+}
+
+// ------------------------------------------------------------------------------------------------
+func (l *Loop) TimeScale() float64 {
+	return l.timeScale
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetTimeScale speeds up (above 1) or slows down (below 1) game time. Negative values are
+// treated as zero.
+func (l *Loop) SetTimeScale(scale float64) {
+	l.timeScale = max(0, scale)
+}
+
+// ------------------------------------------------------------------------------------------------
+// SetMaxFrameTime changes the cap on real time handled per frame, see MAX_FRAME_TIME.
+func (l *Loop) SetMaxFrameTime(seconds float64) {
+	if seconds > 0 {
+		l.maxFrameTime = seconds
+	}
+}
@@ -0,0 +1,125 @@
+package timing
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+// ------------------------------------------------------------------------------------------------
+type countingGame struct {
+	updates int
+	renders int
+	alpha   float64
+}
+
+func (g *countingGame) FixedUpdate(step float64) {
+	g.updates++
+}
+
+func (g *countingGame) Render(alpha float64) {
+	g.renders++
+	g.alpha = alpha
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFixedStepIndependentOfFrameRate(t *testing.T) {
+	slow, fast := &countingGame{}, &countingGame{}
+
+	// one second at 30 and 120 frames per second
+	RunHeadless(NewLoop(slow, 0.01), 30, 1.0/30.0)
+	RunHeadless(NewLoop(fast, 0.01), 120, 1.0/120.0)
+
+	if slow.renders != 30 || fast.renders != 120 {
+		t.Errorf("Expected one render per frame, got %d and %d", slow.renders, fast.renders)
+	}
+
+	// floating point rounding may leave the last step for the next frame
+	if slow.updates < 99 || slow.updates > 100 || fast.updates < 99 || fast.updates > 100 {
+		t.Errorf("Expected about 100 updates for both, got %d and %d", slow.updates, fast.updates)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestAlphaInterpolation(t *testing.T) {
+	game := &countingGame{}
+	loop := NewLoop(game, 0.1)
+
+	if updates := loop.Advance(0.25); updates != 2 {
+		t.Errorf("Expected 2 updates, got %d", updates)
+	}
+
+	if math.Abs(game.alpha-0.5) > 1e-9 {
+		t.Errorf("Expected alpha of 0.5, got %f", game.alpha)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPauseAndTimeScale(t *testing.T) {
+	game := &countingGame{}
+	loop := NewLoop(game, 0.1)
+
+	loop.Pause()
+	loop.Advance(0.2)
+
+	if game.updates != 0 || game.renders != 1 || loop.Delta() != 0 || loop.RawDelta() != 0.2 {
+		t.Error("Expected a paused loop to render without updating")
+	}
+
+	loop.Resume()
+	loop.SetTimeScale(2)
+	loop.Advance(0.1)
+
+	if game.updates != 2 || math.Abs(loop.Elapsed()-0.2) > 1e-9 {
+		t.Errorf("Expected double speed to run 2 updates, got %d", game.updates)
+	}
+
+	loop.SetTimeScale(-1)
+	if loop.TimeScale() != 0 {
+		t.Error("Expected negative time scales to be clamped to zero")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestMaxFrameTime(t *testing.T) {
+	game := &countingGame{}
+	loop := NewLoop(game, 0.1)
+
+	// only MAX_FRAME_TIME of the 10 seconds is handled, which is 2 whole steps
+	loop.Advance(10)
+
+	if game.updates != 2 {
+		t.Errorf("Expected a long frame to be capped, got %d updates", game.updates)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestNaNFrameTimeIsIgnored(t *testing.T) {
+	game := &countingGame{}
+	loop := NewLoop(game, 0.1)
+
+	loop.Advance(math.NaN())
+	loop.Advance(0.25)
+
+	if game.updates != 2 {
+		t.Errorf("Expected updates to carry on after a NaN frame time, got %d updates", game.updates)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRunRealtimeStops(t *testing.T) {
+	game := &countingGame{}
+	loop := NewLoop(game, DEFAULT_STEP)
+	stop := make(chan struct{})
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(stop)
+	}()
+
+	RunRealtime(loop, 200, stop)
+
+	if loop.Frames() == 0 {
+		t.Error("Expected at least one frame to run")
+	}
+}
@@ -0,0 +1,130 @@
+package tween
+
+import (
+	"math"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+)
+
+// ------------------------------------------------------------------------------------------------
+// Manager runs tweens. It is not safe for use by several goroutines at once.
+type Manager struct {
+	tweens   []*Tween
+	updating bool
+}
+
+// ------------------------------------------------------------------------------------------------
+func NewManager() *Manager {
+	return &Manager{}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Float eases the value target points to from where it is to to, over duration seconds.
+func (m *Manager) Float(target *float64, to, duration float64, options ...Option) *Tween {
+	return add(m, target, to, duration, func(from, to, t float64) float64 {
+		return from + (to-from)*t
+	}, options)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Point eases a position, rounding to the nearest pixel.
+func (m *Manager) Point(target *canvas.Point, to canvas.Point, duration float64, options ...Option) *Tween {
+	return add(m, target, to, duration, func(from, to canvas.Point, t float64) canvas.Point {
+		return canvas.Point{X: lerpInt(from.X, to.X, t), Y: lerpInt(from.Y, to.Y, t)}
+	}, options)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Colour eases every channel of a colour, alpha included. Curves that overshoot are clamped
+// to the colour range.
+func (m *Manager) Colour(target *colour.Colour, to colour.Colour, duration float64, options ...Option) *Tween {
+	return add(m, target, to, duration, func(from, to colour.Colour, t float64) colour.Colour {
+		return colour.Colour{
+			R: lerpChannel(from.R, to.R, t),
+			G: lerpChannel(from.G, to.G, t),
+			B: lerpChannel(from.B, to.B, t),
+			A: lerpChannel(from.A, to.A, t),
+		}
+	}, options)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Func calls apply with the eased progress every update, for animating anything else.
+func (m *Manager) Func(duration float64, apply func(progress float64), options ...Option) *Tween {
+	t := newTween(duration, nil, apply, options)
+	m.tweens = append(m.tweens, t)
+	return t
+}
+
+// ------------------------------------------------------------------------------------------------
+// Update moves every tween dt seconds along and drops the ones that are done. Tweens added
+// by completion callbacks start on the next update.
+func (m *Manager) Update(dt float64) {
+	m.updating = true
+	count := len(m.tweens)
+	for i := range count {
+		m.tweens[i].update(dt)
+	}
+	m.updating = false
+
+	running := m.tweens[:0]
+	for _, t := range m.tweens {
+		if !t.done {
+			running = append(running, t)
+		}
+	}
+	clear(m.tweens[len(running):])
+	m.tweens = running
+}
+
+// ------------------------------------------------------------------------------------------------
+// Count returns the number of tweens still running or waiting to start.
+func (m *Manager) Count() int {
+	count := 0
+	for _, t := range m.tweens {
+		if !t.done {
+			count++
+		}
+	}
+	return count
+}
+
+// ------------------------------------------------------------------------------------------------
+// Clear stops every tween. Called from a completion callback, the stopped tweens are removed
+// once Update has finished going through them.
+func (m *Manager) Clear() {
+	for _, t := range m.tweens {
+		t.Stop()
+	}
+
+	if !m.updating {
+		clear(m.tweens)
+		m.tweens = m.tweens[:0]
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// add starts a tween from the value target holds when it starts.
+func add[T any](m *Manager, target *T, to T, duration float64, lerp func(from, to T, t float64) T, options []Option) *Tween {
+	var from T
+	t := newTween(duration,
+		func() { from = *target },
+		func(progress float64) { *target = lerp(from, to, progress) },
+		options,
+	)
+
+	m.tweens = append(m.tweens, t)
+	return t
+}
+
+// ------------------------------------------------------------------------------------------------
+func lerpInt(from, to int, t float64) int {
+	return int(math.Round(float64(from) + float64(to-from)*t))
+}
+
+// ------------------------------------------------------------------------------------------------
+func lerpChannel(from, to uint8, t float64) uint8 {
+	value := math.Round(float64(from) + (float64(to)-float64(from))*t)
+	return uint8(max(0, min(255, value)))
+}
@@ -0,0 +1,172 @@
+// Package tween animates values over time. A Manager owns the running tweens and moves them
+// all along on every Update, easing float64, canvas.Point and colour.Colour values from
+// where they are when the tween starts to a target. Options add a delay, an easing curve,
+// loops, yoyo and a callback for when the tween is done:
+//
+//	tweens := tween.NewManager()
+//	tweens.Float(&alpha, 1, 0.5, tween.WithEase(easing.OutCubic), tween.WithDelay(2))
+//	tweens.Point(&logo, canvas.Point{X: 400, Y: 300}, 1, tween.WithLoops(tween.LOOP_FOREVER), tween.WithYoyo())
+//
+//	// in the game loop
+//	tweens.Update(dt)
+package tween
+
+import "github.com/ewaldhorn/gogi/easing"
+
+// ------------------------------------------------------------------------------------------------
+// LOOP_FOREVER repeats a tween until it is stopped.
+const LOOP_FOREVER = -1
+
+// ------------------------------------------------------------------------------------------------
+// Option configures a tween.
+type Option func(*Tween)
+
+// ------------------------------------------------------------------------------------------------
+// WithDelay waits before starting, in seconds. The starting value is read once the delay is
+// over, so tweens can be queued up one after the other.
+func WithDelay(delay float64) Option {
+	return func(t *Tween) {
+		t.delay = max(0, delay)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithEase picks the easing curve, the default is easing.Linear.
+func WithEase(ease easing.Func) Option {
+	return func(t *Tween) {
+		if ease != nil {
+			t.ease = ease
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithLoops plays the tween count times, or until stopped for LOOP_FOREVER.
+func WithLoops(count int) Option {
+	return func(t *Tween) {
+		if count == LOOP_FOREVER || count > 0 {
+			t.loops = count
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithYoyo plays every second loop backwards, so the value goes there and back again.
+func WithYoyo() Option {
+	return func(t *Tween) {
+		t.yoyo = true
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// OnComplete calls done once the last loop has finished. It isn't called for stopped tweens.
+// done may start new tweens on the manager.
+func OnComplete(done func()) Option {
+	return func(t *Tween) {
+		t.onComplete = done
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Tween moves one value towards its target.
+type Tween struct {
+	duration, delay float64
+	ease            easing.Func
+	loops           int
+	yoyo            bool
+	onComplete      func()
+
+	// start reads the starting value, apply sets the value for eased progress
+	start func()
+	apply func(progress float64)
+
+	started bool
+	elapsed float64
+	played  int
+	done    bool
+}
+
+// ------------------------------------------------------------------------------------------------
+func newTween(duration float64, start func(), apply func(float64), options []Option) *Tween {
+	t := &Tween{
+		duration: duration,
+		ease:     easing.Linear,
+		loops:    1,
+		start:    start,
+		apply:    apply,
+	}
+
+	for _, option := range options {
+		option(t)
+	}
+	return t
+}
+
+// ------------------------------------------------------------------------------------------------
+// Stop ends the tween where it is, without calling its completion callback.
+func (t *Tween) Stop() {
+	t.done = true
+}
+
+// ------------------------------------------------------------------------------------------------
+// IsDone is true once the tween has finished or been stopped.
+func (t *Tween) IsDone() bool {
+	return t.done
+}
+
+// ------------------------------------------------------------------------------------------------
+// update moves the tween dt seconds along, carrying time left over from one loop into the
+// next.
+func (t *Tween) update(dt float64) {
+	if t.done {
+		return
+	}
+
+	if t.delay > 0 {
+		t.delay -= dt
+		if t.delay > 0 {
+			return
+		}
+		dt = -t.delay
+		t.delay = 0
+	}
+
+	if !t.started {
+		t.started = true
+		if t.start != nil {
+			t.start()
+		}
+	}
+
+	t.elapsed += dt
+	for t.duration <= 0 || t.elapsed >= t.duration {
+		if t.duration <= 0 || (t.loops != LOOP_FOREVER && t.played+1 >= t.loops) {
+			t.finish()
+			return
+		}
+		t.elapsed -= t.duration
+		t.played++
+	}
+
+	t.apply(t.ease(t.direction(t.elapsed / t.duration)))
+}
+
+// ------------------------------------------------------------------------------------------------
+// direction turns progress around for the loops yoyo plays backwards.
+func (t *Tween) direction(progress float64) float64 {
+	if t.yoyo && t.played%2 == 1 {
+		return 1 - progress
+	}
+	return progress
+}
+
+// ------------------------------------------------------------------------------------------------
+// finish sets the final value, which is back at the start when the last loop ran backwards.
+func (t *Tween) finish() {
+	t.done = true
+	t.apply(t.ease(t.direction(1)))
+
+	if t.onComplete != nil {
+		t.onComplete()
+	}
+}
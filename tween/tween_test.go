@@ -0,0 +1,200 @@
+package tween
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/easing"
+)
+
+// ------------------------------------------------------------------------------------------------
+// run updates the manager in steps of dt and records the value after every step.
+func run(m *Manager, value *float64, steps int, dt float64) []float64 {
+	values := make([]float64, steps)
+	for i := range values {
+		m.Update(dt)
+		values[i] = *value
+	}
+	return values
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestFloatTweens(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  []Option
+		steps    int
+		expected []float64
+	}{
+		{name: "linear", steps: 5, expected: []float64{2.5, 5, 7.5, 10, 10}},
+		{name: "eased", options: []Option{WithEase(easing.InQuad)}, steps: 2, expected: []float64{0.625, 2.5}},
+		{name: "delayed", options: []Option{WithDelay(0.5)}, steps: 4, expected: []float64{0, 0, 2.5, 5}},
+		{name: "looped", options: []Option{WithLoops(2)}, steps: 6, expected: []float64{2.5, 5, 7.5, 0, 2.5, 5}},
+		{name: "yoyo", options: []Option{WithLoops(2), WithYoyo()}, steps: 9, expected: []float64{2.5, 5, 7.5, 10, 7.5, 5, 2.5, 0, 0}},
+		{name: "forever", options: []Option{WithLoops(LOOP_FOREVER), WithYoyo()}, steps: 10, expected: []float64{2.5, 5, 7.5, 10, 7.5, 5, 2.5, 0, 2.5, 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewManager()
+			value := 0.0
+			m.Float(&value, 10, 1, tt.options...)
+
+			got := run(m, &value, tt.steps, 0.25)
+			for i, expected := range tt.expected {
+				if math.Abs(got[i]-expected) > 1e-9 {
+					t.Fatalf("Expected %v, but got %v", tt.expected, got)
+				}
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTweenStartsFromCurrentValue(t *testing.T) {
+	m := NewManager()
+	value := 0.0
+	m.Float(&value, 10, 1, WithDelay(1))
+
+	m.Update(0.5)
+	value = 6
+	m.Update(0.5)
+	m.Update(0.5)
+
+	if value != 8 {
+		t.Errorf("Expected the tween to start from the value after the delay, but got %f", value)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTweenCarriesLeftOverTime(t *testing.T) {
+	m := NewManager()
+	value := 0.0
+	m.Float(&value, 10, 1, WithLoops(3))
+
+	// one big step lands a quarter of the way into the third loop
+	m.Update(2.25)
+	if value != 2.5 {
+		t.Errorf("Expected 2.5, but got %f", value)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTweenCompletion(t *testing.T) {
+	m := NewManager()
+	value, completed := 0.0, 0
+
+	first := m.Float(&value, 10, 1, OnComplete(func() {
+		completed++
+		// chain a second tween back to zero
+		m.Float(&value, 0, 1)
+	}))
+
+	m.Update(1)
+	if completed != 1 || value != 10 || !first.IsDone() {
+		t.Fatalf("Expected the first tween to complete at 10, but got %d calls and %f", completed, value)
+	}
+	if m.Count() != 1 {
+		t.Fatalf("Expected the chained tween to be waiting, but got %d tweens", m.Count())
+	}
+
+	m.Update(0.5)
+	m.Update(5)
+	if completed != 1 || value != 0 || m.Count() != 0 {
+		t.Errorf("Expected the chained tween to finish at 0, but got %f with %d tweens", value, m.Count())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTweenStop(t *testing.T) {
+	m := NewManager()
+	value, called := 0.0, false
+
+	tw := m.Float(&value, 10, 1, OnComplete(func() { called = true }))
+	m.Update(0.5)
+	tw.Stop()
+	m.Update(1)
+
+	if value != 5 || called || m.Count() != 0 {
+		t.Errorf("Expected a stopped tween to stay at 5 without a callback, but got %f", value)
+	}
+
+	m.Float(&value, 1, 1)
+	m.Func(1, func(float64) {})
+	m.Clear()
+	if m.Count() != 0 {
+		t.Errorf("Expected Clear to remove every tween, but got %d", m.Count())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestClearFromCallback(t *testing.T) {
+	m := NewManager()
+	first, second, third := 0.0, 0.0, 0.0
+
+	m.Float(&first, 10, 1, OnComplete(func() {
+		m.Clear()
+		// a tween started after clearing still runs
+		m.Float(&third, 10, 1)
+	}))
+	m.Float(&second, 10, 2)
+
+	m.Update(1)
+	if first != 10 || second != 0 || m.Count() != 1 {
+		t.Fatalf("Expected Clear to stop the other tween, but got %f, %f and %d tweens", first, second, m.Count())
+	}
+
+	m.Update(1)
+	if second != 0 || third != 10 || m.Count() != 0 {
+		t.Errorf("Expected only the new tween to run, but got %f and %f with %d tweens", second, third, m.Count())
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestTweenZeroDuration(t *testing.T) {
+	m := NewManager()
+	value := 0.0
+	m.Float(&value, 3, 0, WithLoops(LOOP_FOREVER))
+
+	m.Update(0.1)
+	if value != 3 || m.Count() != 0 {
+		t.Errorf("Expected a zero length tween to jump to its target, but got %f", value)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestPointAndColourTweens(t *testing.T) {
+	m := NewManager()
+
+	p := canvas.Point{X: 0, Y: 10}
+	m.Point(&p, canvas.Point{X: 5, Y: -10}, 1)
+
+	c := colour.NewColour(0, 100, 255, 0)
+	m.Colour(&c, colour.NewColour(255, 100, 0, 255), 1)
+
+	// OutBack overshoots, which must not wrap the channels around
+	overshoot := colour.NewColour(0, 0, 0, 255)
+	m.Colour(&overshoot, colour.NewColour(250, 5, 0, 255), 1, WithEase(easing.OutBack))
+
+	progress := 0.0
+	m.Func(1, func(t float64) { progress = t })
+
+	m.Update(0.5)
+
+	if p != (canvas.Point{X: 3, Y: 0}) {
+		t.Errorf("Expected the point half way, rounded, but got %v", p)
+	}
+	if c != colour.NewColour(128, 100, 128, 128) {
+		t.Errorf("Expected the colour half way, but got %v", c)
+	}
+	if progress != 0.5 {
+		t.Errorf("Expected the function to get half way, but got %f", progress)
+	}
+
+	m.Update(0.3)
+	if overshoot.R != 255 || overshoot.G < 5 {
+		t.Errorf("Expected the overshooting colour to clamp, but got %v", overshoot)
+	}
+}
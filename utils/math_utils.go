@@ -1,6 +1,10 @@
 package utils
 
-import "math/rand/v2"
+import (
+	"math/rand/v2"
+
+	"github.com/ewaldhorn/gogi/randomness"
+)
 
 // ------------------------------------------------------------------------------------------------
 // RandomSignFloat returns n or -n with a roughly 50% chance.
@@ -20,6 +24,24 @@ func RandomSignInt(n int) int {
 	return n
 }
 
+// ------------------------------------------------------------------------------------------------
+// RandomSignFloatFrom is RandomSignFloat drawing from the given source.
+func RandomSignFloatFrom(source *randomness.Source, n float64) float64 {
+	if source.Bool() {
+		return -n
+	}
+	return n
+}
+
+// ------------------------------------------------------------------------------------------------
+// RandomSignIntFrom is RandomSignInt drawing from the given source.
+func RandomSignIntFrom(source *randomness.Source, n int) int {
+	if source.Bool() {
+		return -n
+	}
+	return n
+}
+
 // ------------------------------------------------------------------------------------------------
 func ClampUInt8(initial uint8, diff int) uint8 {
 	newValue := int(initial) + diff
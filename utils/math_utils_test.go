@@ -3,6 +3,8 @@ package utils
 import (
 	"math"
 	"testing"
+
+	"github.com/ewaldhorn/gogi/randomness"
 )
 
 // ------------------------------------------------------------------------------------------------
@@ -98,6 +100,34 @@ func TestRandomSignFloat(t *testing.T) {
 	}
 }
 
+// ------------------------------------------------------------------------------------------------
+func TestRandomSignFrom(t *testing.T) {
+	a, b := randomness.NewSource(4), randomness.NewSource(4)
+	negatives := 0
+
+	for range 100 {
+		result := RandomSignFloatFrom(a, 2.5)
+		if result != 2.5 && result != -2.5 {
+			t.Fatalf("Expected 2.5 or -2.5, but got %f", result)
+		}
+		if result < 0 {
+			negatives++
+		}
+
+		if expected := RandomSignFloatFrom(b, 2.5); result != expected {
+			t.Fatalf("Expected the same seed to give %f, but got %f", expected, result)
+		}
+
+		if got := RandomSignIntFrom(a, 3); got != RandomSignIntFrom(b, 3) || (got != 3 && got != -3) {
+			t.Fatalf("Expected matching results of 3 or -3, but got %d", got)
+		}
+	}
+
+	if negatives == 0 || negatives == 100 {
+		t.Errorf("Expected a mix of signs, but got %d negatives out of 100", negatives)
+	}
+}
+
 // ------------------------------------------------------------------------------------------------
 func TestRandomSignInt(t *testing.T) {
 	n := 123
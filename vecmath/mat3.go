@@ -0,0 +1,115 @@
+package vecmath
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+// Mat3 is a 3x3 matrix stored row by row. As a 2D affine transform the last row is 0, 0, 1
+// and the translation is in the last column.
+type Mat3 [9]float64
+
+// ------------------------------------------------------------------------------------------------
+func Identity() Mat3 {
+	return Mat3{
+		1, 0, 0,
+		0, 1, 0,
+		0, 0, 1,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func Translation(x, y float64) Mat3 {
+	return Mat3{
+		1, 0, x,
+		0, 1, y,
+		0, 0, 1,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Rotation turns around the origin.
+func Rotation(angle float64) Mat3 {
+	sin, cos := math.Sincos(angle)
+	return Mat3{
+		cos, -sin, 0,
+		sin, cos, 0,
+		0, 0, 1,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Scaling scales away from the origin.
+func Scaling(x, y float64) Mat3 {
+	return Mat3{
+		x, 0, 0,
+		0, y, 0,
+		0, 0, 1,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Mul returns m x o, the transform that applies o first and then m. To rotate a shape around
+// its centre c:
+//
+//	Translation(c.X, c.Y).Mul(Rotation(angle)).Mul(Translation(-c.X, -c.Y))
+func (m Mat3) Mul(o Mat3) Mat3 {
+	var result Mat3
+	for row := range 3 {
+		for column := range 3 {
+			result[row*3+column] = m[row*3]*o[column] + m[row*3+1]*o[3+column] + m[row*3+2]*o[6+column]
+		}
+	}
+	return result
+}
+
+// ------------------------------------------------------------------------------------------------
+// Transform applies the matrix to a position, including the translation.
+func (m Mat3) Transform(v Vec2) Vec2 {
+	return m.MulVec3(v.Vec3(1)).XY()
+}
+
+// ------------------------------------------------------------------------------------------------
+// TransformDirection applies the matrix to a direction, which ignores the translation.
+func (m Mat3) TransformDirection(v Vec2) Vec2 {
+	return m.MulVec3(v.Vec3(0)).XY()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m Mat3) MulVec3(v Vec3) Vec3 {
+	return Vec3{
+		X: m[0]*v.X + m[1]*v.Y + m[2]*v.Z,
+		Y: m[3]*v.X + m[4]*v.Y + m[5]*v.Z,
+		Z: m[6]*v.X + m[7]*v.Y + m[8]*v.Z,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m Mat3) Transpose() Mat3 {
+	return Mat3{
+		m[0], m[3], m[6],
+		m[1], m[4], m[7],
+		m[2], m[5], m[8],
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (m Mat3) Determinant() float64 {
+	return m[0]*(m[4]*m[8]-m[5]*m[7]) - m[1]*(m[3]*m[8]-m[5]*m[6]) + m[2]*(m[3]*m[7]-m[4]*m[6])
+}
+
+// ------------------------------------------------------------------------------------------------
+// Inverse returns the transform that undoes m, such as mapping screen positions back into a
+// rotated shape. It returns false when m squashes everything flat and has no inverse.
+func (m Mat3) Inverse() (Mat3, bool) {
+	determinant := m.Determinant()
+	if determinant == 0 {
+		return Mat3{}, false
+	}
+
+	// the transposed matrix of cofactors, divided by the determinant
+	d := 1 / determinant
+	return Mat3{
+		(m[4]*m[8] - m[5]*m[7]) * d, (m[2]*m[7] - m[1]*m[8]) * d, (m[1]*m[5] - m[2]*m[4]) * d,
+		(m[5]*m[6] - m[3]*m[8]) * d, (m[0]*m[8] - m[2]*m[6]) * d, (m[2]*m[3] - m[0]*m[5]) * d,
+		(m[3]*m[7] - m[4]*m[6]) * d, (m[1]*m[6] - m[0]*m[7]) * d, (m[0]*m[4] - m[1]*m[3]) * d,
+	}, true
+}
@@ -0,0 +1,68 @@
+package vecmath
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+func TestMat3Transforms(t *testing.T) {
+	centre := Vec2{X: 10, Y: 5}
+	aroundCentre := Translation(centre.X, centre.Y).Mul(Rotation(math.Pi / 2)).Mul(Translation(-centre.X, -centre.Y))
+
+	tests := []struct {
+		name     string
+		m        Mat3
+		point    Vec2
+		expected Vec2
+	}{
+		{name: "identity", m: Identity(), point: Vec2{X: 3, Y: -2}, expected: Vec2{X: 3, Y: -2}},
+		{name: "translation", m: Translation(5, -1), point: Vec2{X: 3, Y: -2}, expected: Vec2{X: 8, Y: -3}},
+		{name: "rotation", m: Rotation(math.Pi / 2), point: Vec2{X: 2}, expected: Vec2{Y: 2}},
+		{name: "scaling", m: Scaling(2, 3), point: Vec2{X: 1, Y: 1}, expected: Vec2{X: 2, Y: 3}},
+		{name: "around centre", m: aroundCentre, point: Vec2{X: 12, Y: 5}, expected: Vec2{X: 10, Y: 7}},
+		{name: "centre stays put", m: aroundCentre, point: centre, expected: centre},
+		{name: "scale then move", m: Translation(1, 1).Mul(Scaling(2, 2)), point: Vec2{X: 1, Y: 2}, expected: Vec2{X: 3, Y: 5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.Transform(tt.point); !near2(got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, got)
+			}
+		})
+	}
+
+	if got := Translation(5, 5).TransformDirection(Vec2{X: 1}); got != (Vec2{X: 1}) {
+		t.Errorf("Expected directions to ignore translation, but got %v", got)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestMat3Inverse(t *testing.T) {
+	m := Translation(4, -3).Mul(Rotation(0.7)).Mul(Scaling(2, 0.5))
+
+	inverse, ok := m.Inverse()
+	if !ok {
+		t.Fatal("Expected an inverse")
+	}
+
+	product := m.Mul(inverse)
+	for i, value := range Identity() {
+		if math.Abs(product[i]-value) > tolerance {
+			t.Fatalf("Expected m times its inverse to be the identity, but got %v", product)
+		}
+	}
+
+	if math.Abs(m.Determinant()-1) > tolerance {
+		t.Errorf("Expected a determinant of 1, but got %f", m.Determinant())
+	}
+
+	if _, ok := Scaling(1, 0).Inverse(); ok {
+		t.Error("Expected no inverse for a flattening transform")
+	}
+
+	if m.Transpose().Transpose() != m || Rotation(0.3).Transpose() != Rotation(-0.3) {
+		t.Error("Expected the transpose of a rotation to rotate back")
+	}
+}
@@ -0,0 +1,111 @@
+// Package vecmath has the float vectors and matrices shared by drawing primitives,
+// transforms and particles. Vec2 is a position or direction on screen, Vec3 adds depth or a
+// homogeneous coordinate, and Mat3 is a 2D affine transform. All types are values, so
+// operations return new vectors rather than changing their receiver. Angles are in radians,
+// with positive angles turning from the X axis towards the Y axis, which is clockwise on
+// screen where Y points down.
+package vecmath
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+type Vec2 struct {
+	X, Y float64
+}
+
+// ------------------------------------------------------------------------------------------------
+// FromAngle returns the unit vector pointing at angle.
+func FromAngle(angle float64) Vec2 {
+	return Vec2{X: math.Cos(angle), Y: math.Sin(angle)}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec2) Add(o Vec2) Vec2 {
+	return Vec2{X: v.X + o.X, Y: v.Y + o.Y}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec2) Sub(o Vec2) Vec2 {
+	return Vec2{X: v.X - o.X, Y: v.Y - o.Y}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec2) Scale(s float64) Vec2 {
+	return Vec2{X: v.X * s, Y: v.Y * s}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec2) Dot(o Vec2) float64 {
+	return v.X*o.X + v.Y*o.Y
+}
+
+// ------------------------------------------------------------------------------------------------
+// Cross returns the Z part of the 3D cross product. It is positive when o is clockwise from v
+// on screen, and zero when they are parallel.
+func (v Vec2) Cross(o Vec2) float64 {
+	return v.X*o.Y - v.Y*o.X
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec2) Length() float64 {
+	return math.Hypot(v.X, v.Y)
+}
+
+// ------------------------------------------------------------------------------------------------
+// LengthSquared skips the square root, for comparing lengths.
+func (v Vec2) LengthSquared() float64 {
+	return v.X*v.X + v.Y*v.Y
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec2) Distance(o Vec2) float64 {
+	return v.Sub(o).Length()
+}
+
+// ------------------------------------------------------------------------------------------------
+// Normalise returns the unit vector in the same direction. The zero vector stays zero.
+func (v Vec2) Normalise() Vec2 {
+	length := v.Length()
+	if length == 0 {
+		return v
+	}
+	return v.Scale(1 / length)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Lerp moves from v towards o, reaching o when t is 1.
+func (v Vec2) Lerp(o Vec2, t float64) Vec2 {
+	return Vec2{X: v.X + (o.X-v.X)*t, Y: v.Y + (o.Y-v.Y)*t}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Rotate turns the vector around the origin.
+func (v Vec2) Rotate(angle float64) Vec2 {
+	sin, cos := math.Sincos(angle)
+	return Vec2{X: v.X*cos - v.Y*sin, Y: v.X*sin + v.Y*cos}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Reflect bounces the vector off a surface with the given unit normal.
+func (v Vec2) Reflect(normal Vec2) Vec2 {
+	return v.Sub(normal.Scale(2 * v.Dot(normal)))
+}
+
+// ------------------------------------------------------------------------------------------------
+// Perpendicular returns the vector turned a quarter turn, clockwise on screen.
+func (v Vec2) Perpendicular() Vec2 {
+	return Vec2{X: -v.Y, Y: v.X}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Angle returns the direction the vector points in, from -Pi to Pi.
+func (v Vec2) Angle() float64 {
+	return math.Atan2(v.Y, v.X)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Vec3 returns the vector with z added, use 1 for positions and 0 for directions when
+// multiplying by a Mat3.
+func (v Vec2) Vec3(z float64) Vec3 {
+	return Vec3{X: v.X, Y: v.Y, Z: z}
+}
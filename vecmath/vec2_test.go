@@ -0,0 +1,72 @@
+package vecmath
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+const tolerance = 1e-12
+
+// ------------------------------------------------------------------------------------------------
+func near2(a, b Vec2) bool {
+	return math.Abs(a.X-b.X) < tolerance && math.Abs(a.Y-b.Y) < tolerance
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestVec2Operations(t *testing.T) {
+	a, b := Vec2{X: 3, Y: 4}, Vec2{X: -1, Y: 2}
+
+	tests := []struct {
+		name     string
+		got      Vec2
+		expected Vec2
+	}{
+		{name: "add", got: a.Add(b), expected: Vec2{X: 2, Y: 6}},
+		{name: "sub", got: a.Sub(b), expected: Vec2{X: 4, Y: 2}},
+		{name: "scale", got: a.Scale(-2), expected: Vec2{X: -6, Y: -8}},
+		{name: "normalise", got: a.Normalise(), expected: Vec2{X: 0.6, Y: 0.8}},
+		{name: "normalise zero", got: Vec2{}.Normalise(), expected: Vec2{}},
+		{name: "lerp", got: a.Lerp(b, 0.25), expected: Vec2{X: 2, Y: 3.5}},
+		{name: "rotate quarter", got: Vec2{X: 1}.Rotate(math.Pi / 2), expected: Vec2{Y: 1}},
+		{name: "rotate half", got: a.Rotate(math.Pi), expected: Vec2{X: -3, Y: -4}},
+		{name: "reflect", got: Vec2{X: 1, Y: 1}.Reflect(Vec2{Y: -1}), expected: Vec2{X: 1, Y: -1}},
+		{name: "perpendicular", got: a.Perpendicular(), expected: Vec2{X: -4, Y: 3}},
+		{name: "from angle", got: FromAngle(math.Pi / 2), expected: Vec2{Y: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !near2(tt.got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, tt.got)
+			}
+		})
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestVec2Measures(t *testing.T) {
+	a, b := Vec2{X: 3, Y: 4}, Vec2{X: -1, Y: 2}
+
+	tests := []struct {
+		name     string
+		got      float64
+		expected float64
+	}{
+		{name: "dot", got: a.Dot(b), expected: 5},
+		{name: "cross", got: a.Cross(b), expected: 10},
+		{name: "cross parallel", got: a.Cross(a.Scale(3)), expected: 0},
+		{name: "length", got: a.Length(), expected: 5},
+		{name: "length squared", got: a.LengthSquared(), expected: 25},
+		{name: "distance", got: a.Distance(b), expected: math.Sqrt(20)},
+		{name: "angle", got: Vec2{X: -1}.Angle(), expected: math.Pi},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if math.Abs(tt.got-tt.expected) > tolerance {
+				t.Errorf("Expected %f, but got %f", tt.expected, tt.got)
+			}
+		})
+	}
+}
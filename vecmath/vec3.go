@@ -0,0 +1,85 @@
+package vecmath
+
+import "math"
+
+// ------------------------------------------------------------------------------------------------
+type Vec3 struct {
+	X, Y, Z float64
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) Add(o Vec3) Vec3 {
+	return Vec3{X: v.X + o.X, Y: v.Y + o.Y, Z: v.Z + o.Z}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) Sub(o Vec3) Vec3 {
+	return Vec3{X: v.X - o.X, Y: v.Y - o.Y, Z: v.Z - o.Z}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) Scale(s float64) Vec3 {
+	return Vec3{X: v.X * s, Y: v.Y * s, Z: v.Z * s}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) Dot(o Vec3) float64 {
+	return v.X*o.X + v.Y*o.Y + v.Z*o.Z
+}
+
+// ------------------------------------------------------------------------------------------------
+// Cross returns a vector at right angles to both v and o, with the length of the area of the
+// parallelogram they span.
+func (v Vec3) Cross(o Vec3) Vec3 {
+	return Vec3{
+		X: v.Y*o.Z - v.Z*o.Y,
+		Y: v.Z*o.X - v.X*o.Z,
+		Z: v.X*o.Y - v.Y*o.X,
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) Length() float64 {
+	return math.Sqrt(v.LengthSquared())
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) LengthSquared() float64 {
+	return v.X*v.X + v.Y*v.Y + v.Z*v.Z
+}
+
+// ------------------------------------------------------------------------------------------------
+// Normalise returns the unit vector in the same direction. The zero vector stays zero.
+func (v Vec3) Normalise() Vec3 {
+	length := v.Length()
+	if length == 0 {
+		return v
+	}
+	return v.Scale(1 / length)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (v Vec3) Lerp(o Vec3, t float64) Vec3 {
+	return Vec3{X: v.X + (o.X-v.X)*t, Y: v.Y + (o.Y-v.Y)*t, Z: v.Z + (o.Z-v.Z)*t}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Rotate turns the vector around a unit axis, using Rodrigues' rotation formula.
+func (v Vec3) Rotate(axis Vec3, angle float64) Vec3 {
+	sin, cos := math.Sincos(angle)
+	return v.Scale(cos).
+		Add(axis.Cross(v).Scale(sin)).
+		Add(axis.Scale(axis.Dot(v) * (1 - cos)))
+}
+
+// ------------------------------------------------------------------------------------------------
+// Reflect bounces the vector off a surface with the given unit normal.
+func (v Vec3) Reflect(normal Vec3) Vec3 {
+	return v.Sub(normal.Scale(2 * v.Dot(normal)))
+}
+
+// ------------------------------------------------------------------------------------------------
+// XY drops the Z part.
+func (v Vec3) XY() Vec2 {
+	return Vec2{X: v.X, Y: v.Y}
+}
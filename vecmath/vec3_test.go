@@ -0,0 +1,51 @@
+package vecmath
+
+import (
+	"math"
+	"testing"
+)
+
+// ------------------------------------------------------------------------------------------------
+func near3(a, b Vec3) bool {
+	return math.Abs(a.X-b.X) < tolerance && math.Abs(a.Y-b.Y) < tolerance && math.Abs(a.Z-b.Z) < tolerance
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestVec3Operations(t *testing.T) {
+	x, y, z := Vec3{X: 1}, Vec3{Y: 1}, Vec3{Z: 1}
+	a, b := Vec3{X: 1, Y: 2, Z: 2}, Vec3{X: -2, Y: 0, Z: 1}
+
+	tests := []struct {
+		name     string
+		got      Vec3
+		expected Vec3
+	}{
+		{name: "add", got: a.Add(b), expected: Vec3{X: -1, Y: 2, Z: 3}},
+		{name: "sub", got: a.Sub(b), expected: Vec3{X: 3, Y: 2, Z: 1}},
+		{name: "scale", got: a.Scale(2), expected: Vec3{X: 2, Y: 4, Z: 4}},
+		{name: "cross axes", got: x.Cross(y), expected: z},
+		{name: "cross", got: a.Cross(b), expected: Vec3{X: 2, Y: -5, Z: 4}},
+		{name: "normalise", got: a.Normalise(), expected: Vec3{X: 1.0 / 3, Y: 2.0 / 3, Z: 2.0 / 3}},
+		{name: "normalise zero", got: Vec3{}.Normalise(), expected: Vec3{}},
+		{name: "lerp", got: a.Lerp(b, 0.5), expected: Vec3{X: -0.5, Y: 1, Z: 1.5}},
+		{name: "rotate around z", got: x.Rotate(z, math.Pi/2), expected: y},
+		{name: "rotate around itself", got: a.Rotate(a.Normalise(), 1.3), expected: a},
+		{name: "reflect", got: Vec3{X: 1, Y: -1, Z: 2}.Reflect(y), expected: Vec3{X: 1, Y: 1, Z: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !near3(tt.got, tt.expected) {
+				t.Errorf("Expected %v, but got %v", tt.expected, tt.got)
+			}
+		})
+	}
+
+	if a.Dot(b) != 0 || a.Length() != 3 || a.Cross(b).Dot(a) != 0 {
+		t.Errorf("Expected a dot of 0 and length of 3, but got %f and %f", a.Dot(b), a.Length())
+	}
+
+	if a.XY() != (Vec2{X: 1, Y: 2}) || (Vec2{X: 1, Y: 2}).Vec3(2) != a {
+		t.Error("Expected conversions between Vec2 and Vec3 to keep X and Y")
+	}
+}
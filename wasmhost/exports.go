@@ -0,0 +1,156 @@
+package wasmhost
+
+import "github.com/ewaldhorn/gogi/input"
+
+// The functions below are exported to JavaScript by TinyGo. They are kept small and only
+// translate the raw WebAssembly values before handing over to the host.
+
+// ------------------------------------------------------------------------------------------------
+// gogiInit creates the canvas and initialises the registered app.
+//
+//export gogiInit
+func gogiInit(width, height int32) {
+	current.init(int(width), int(height))
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiUpdate advances the app by dt seconds and draws the next frame.
+//
+//export gogiUpdate
+func gogiUpdate(dt float64) {
+	current.update(dt)
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiResize changes the canvas size. JavaScript must fetch the buffer pointer again afterwards.
+//
+//export gogiResize
+func gogiResize(width, height int32) {
+	current.resize(int(width), int(height))
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiBufferPointer returns the memory address of the visible pixel buffer.
+//
+//export gogiBufferPointer
+func gogiBufferPointer() uintptr {
+	if current.canvas == nil {
+		return 0
+	}
+	return current.canvas.GetBufferPointer()
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiBufferLength returns the size of the pixel buffer in bytes.
+//
+//export gogiBufferLength
+func gogiBufferLength() uint32 {
+	if current.canvas == nil {
+		return 0
+	}
+	return current.canvas.GetBufferLength()
+}
+
+// ------------------------------------------------------------------------------------------------
+//
+//export gogiBufferWidth
+func gogiBufferWidth() int32 {
+	if current.canvas == nil {
+		return 0
+	}
+	return int32(current.canvas.Width())
+}
+
+// ------------------------------------------------------------------------------------------------
+//
+//export gogiBufferHeight
+func gogiBufferHeight() int32 {
+	if current.canvas == nil {
+		return 0
+	}
+	return int32(current.canvas.Height())
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiDirtyRectCount returns how many areas of the canvas changed since the last upload.
+//
+//export gogiDirtyRectCount
+func gogiDirtyRectCount() uint32 {
+	if current.canvas == nil {
+		return 0
+	}
+	return current.canvas.GetDirtyRectCount()
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiDirtyRectsPointer returns the address of the dirty rectangles as int32 quadruplets.
+//
+//export gogiDirtyRectsPointer
+func gogiDirtyRectsPointer() uintptr {
+	if current.canvas == nil {
+		return 0
+	}
+	return current.canvas.GetDirtyRectsPointer()
+}
+
+// ------------------------------------------------------------------------------------------------
+//
+//export gogiClearDirtyRects
+func gogiClearDirtyRects() {
+	if current.canvas != nil {
+		current.canvas.ClearDirtyRects()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiKeyEvent receives key presses and releases, using the browser keyCode values.
+//
+//export gogiKeyEvent
+func gogiKeyEvent(code, down int32) {
+	kind := input.EventKeyUp
+	if down != 0 {
+		kind = input.EventKeyDown
+	}
+	current.handleEvent(input.Event{Kind: kind, Code: int(code)})
+}
+
+// ------------------------------------------------------------------------------------------------
+//
+//export gogiMouseMove
+func gogiMouseMove(x, y int32) {
+	current.handleEvent(input.Event{Kind: input.EventMouseMove, X: int(x), Y: int(y)})
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiMouseButton receives button presses and releases, using the browser button numbers.
+//
+//export gogiMouseButton
+func gogiMouseButton(button, down, x, y int32) {
+	kind := input.EventMouseUp
+	if down != 0 {
+		kind = input.EventMouseDown
+	}
+	current.handleEvent(input.Event{Kind: kind, Code: int(button), X: int(x), Y: int(y)})
+}
+
+// ------------------------------------------------------------------------------------------------
+//
+//export gogiMouseWheel
+func gogiMouseWheel(dx, dy float64) {
+	current.handleEvent(input.Event{Kind: input.EventMouseWheel, DeltaX: dx, DeltaY: dy})
+}
+
+// ------------------------------------------------------------------------------------------------
+// gogiTouchEvent receives touch changes, phase being 0 for start, 1 for move and 2 for end.
+//
+//export gogiTouchEvent
+func gogiTouchEvent(phase, id, x, y int32) {
+	kind := input.EventTouchEnd
+	switch phase {
+	case 0:
+		kind = input.EventTouchStart
+	case 1:
+		kind = input.EventTouchMove
+	}
+	current.handleEvent(input.Event{Kind: kind, Code: int(id), X: int(x), Y: int(y)})
+}
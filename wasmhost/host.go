@@ -0,0 +1,151 @@
+// Package wasmhost provides the standard set of WebAssembly exports a gogi app needs, so
+// an effect only has to implement the App interface and register itself from main.
+// The matching JavaScript loader lives in docs/gogi_host.js.
+package wasmhost
+
+import (
+	"github.com/ewaldhorn/gogi/buffers"
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/input"
+)
+
+// ------------------------------------------------------------------------------------------------
+// App is implemented by anything that wants to draw on a canvas hosted in the browser.
+type App interface {
+	// Init is called once the host knows the canvas size.
+	Init(c *canvas.GogiCanvas)
+	// Update is called once per animation frame with the elapsed time in seconds.
+	Update(dt float64)
+}
+
+// ------------------------------------------------------------------------------------------------
+// Resizer is optionally implemented by apps that want to know when the canvas changes size.
+// Apps without it get Init called again with the resized canvas.
+type Resizer interface {
+	Resize(c *canvas.GogiCanvas)
+}
+
+// ------------------------------------------------------------------------------------------------
+// InputHandler is optionally implemented by apps that want every input event as it arrives.
+// Apps that prefer polling can use the state kept by Input() instead.
+type InputHandler interface {
+	HandleEvent(e input.Event)
+}
+
+// ------------------------------------------------------------------------------------------------
+// frameEnder is implemented by apps that don't look at the input on every update. The host
+// only resets the per frame input state once the app reports it has seen it.
+type frameEnder interface {
+	inputSeen() bool
+}
+
+// ------------------------------------------------------------------------------------------------
+// Option configures the host when registering an app.
+type Option func(*host)
+
+// ------------------------------------------------------------------------------------------------
+// WithPages sets the number of canvas pages. With more than one page the host presents the
+// canvas after every update, so JavaScript only ever sees completed frames.
+func WithPages(pageCount int) Option {
+	return func(h *host) {
+		h.pageCount = max(1, pageCount)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// WithResizeMode decides what happens to the canvas content when the host resizes it. The
+// default is buffers.ResizeClear.
+func WithResizeMode(mode buffers.ResizeMode) Option {
+	return func(h *host) {
+		h.resizeMode = mode
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+type host struct {
+	app        App
+	canvas     *canvas.GogiCanvas
+	input      *input.Manager
+	pageCount  int
+	resizeMode buffers.ResizeMode
+}
+
+// ------------------------------------------------------------------------------------------------
+var current = host{input: input.NewManager(), pageCount: 1}
+
+// ------------------------------------------------------------------------------------------------
+// Register makes app the one driven by the exported functions. Call it from main, which the
+// JavaScript loader runs before initialising the app.
+func Register(app App, options ...Option) {
+	current = host{app: app, input: input.NewManager(), pageCount: 1}
+
+	for _, option := range options {
+		option(&current)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+// Canvas returns the canvas the registered app draws on, nil before initialisation.
+func Canvas() *canvas.GogiCanvas {
+	return current.canvas
+}
+
+// ------------------------------------------------------------------------------------------------
+// Input returns the input state fed by the exported input functions. The per frame state,
+// such as pressed keys, is reset after every update. Games hosted with RegisterLoop keep it
+// until a fixed update has run.
+func Input() *input.Manager {
+	return current.input
+}
+
+// ------------------------------------------------------------------------------------------------
+func (h *host) init(width, height int) {
+	h.canvas = canvas.NewCanvasWithPages(width, height, h.pageCount)
+
+	if h.app != nil {
+		h.app.Init(h.canvas)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (h *host) update(dt float64) {
+	if h.app == nil || h.canvas == nil {
+		return
+	}
+
+	h.app.Update(dt)
+	h.canvas.Present()
+
+	if ender, ok := h.app.(frameEnder); !ok || ender.inputSeen() {
+		h.input.EndFrame()
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (h *host) resize(width, height int) {
+	if h.canvas == nil || width <= 0 || height <= 0 {
+		return
+	}
+
+	if width == h.canvas.Width() && height == h.canvas.Height() {
+		return
+	}
+
+	h.canvas.Resize(width, height, h.resizeMode)
+
+	// apps that don't handle resizing simply start over on the resized canvas
+	if resizer, ok := h.app.(Resizer); ok {
+		resizer.Resize(h.canvas)
+	} else if h.app != nil {
+		h.app.Init(h.canvas)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (h *host) handleEvent(e input.Event) {
+	h.input.HandleEvent(e)
+
+	if handler, ok := h.app.(InputHandler); ok {
+		handler.HandleEvent(e)
+	}
+}
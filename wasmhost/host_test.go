@@ -0,0 +1,158 @@
+package wasmhost
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/colour"
+	"github.com/ewaldhorn/gogi/input"
+)
+
+// ------------------------------------------------------------------------------------------------
+type testApp struct {
+	canvas  *canvas.GogiCanvas
+	inits   int
+	elapsed float64
+	events  []input.Event
+}
+
+func (a *testApp) Init(c *canvas.GogiCanvas) {
+	a.canvas = c
+	a.inits++
+}
+
+func (a *testApp) Update(dt float64) {
+	a.elapsed += dt
+	a.canvas.ColourPutPixel(0, 0, colour.NewColourWhite())
+}
+
+func (a *testApp) HandleEvent(e input.Event) {
+	a.events = append(a.events, e)
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestInitAndUpdate(t *testing.T) {
+	app := &testApp{}
+	Register(app)
+
+	gogiInit(40, 30)
+
+	if app.inits != 1 || Canvas() != app.canvas {
+		t.Fatal("Expected the app to be initialised with the host canvas")
+	}
+
+	if gogiBufferWidth() != 40 || gogiBufferHeight() != 30 || gogiBufferLength() != 40*30*4 {
+		t.Errorf("Unexpected buffer dimensions %dx%d (%d bytes)", gogiBufferWidth(), gogiBufferHeight(), gogiBufferLength())
+	}
+
+	gogiUpdate(0.5)
+	gogiUpdate(0.25)
+
+	if app.elapsed != 0.75 {
+		t.Errorf("Expected 0.75 seconds of updates, got %f", app.elapsed)
+	}
+
+	if gogiBufferPointer() != app.canvas.GetBufferPointer() {
+		t.Error("Expected the exported pointer to match the canvas")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestUpdatePresentsPages(t *testing.T) {
+	app := &testApp{}
+	Register(app, WithPages(2))
+	gogiInit(10, 10)
+
+	before := gogiBufferPointer()
+	gogiUpdate(0.1)
+
+	if gogiBufferPointer() != before || app.canvas.ActivePage() != 1 {
+		t.Error("Expected the completed first page to be visible after the update")
+	}
+
+	gogiUpdate(0.1)
+	if gogiBufferPointer() == before {
+		t.Error("Expected the second update to show the other page")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestResizeReinitialisesApp(t *testing.T) {
+	app := &testApp{}
+	Register(app)
+	gogiInit(10, 10)
+
+	gogiResize(10, 10)
+	if app.inits != 1 {
+		t.Error("Expected resizing to the same size to do nothing")
+	}
+
+	gogiResize(20, 15)
+	if app.inits != 2 || app.canvas.Width() != 20 || app.canvas.Height() != 15 {
+		t.Error("Expected the app to be initialised again with the resized canvas")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestInputEventsForwarded(t *testing.T) {
+	app := &testApp{}
+	Register(app)
+	gogiInit(10, 10)
+
+	gogiKeyEvent(32, 1)
+	gogiMouseButton(0, 0, 3, 4)
+	gogiMouseWheel(0, -1.5)
+	gogiTouchEvent(1, 7, 5, 6)
+
+	expected := []input.Event{
+		{Kind: input.EventKeyDown, Code: 32},
+		{Kind: input.EventMouseUp, Code: 0, X: 3, Y: 4},
+		{Kind: input.EventMouseWheel, DeltaY: -1.5},
+		{Kind: input.EventTouchMove, Code: 7, X: 5, Y: 6},
+	}
+
+	if len(app.events) != len(expected) {
+		t.Fatalf("Expected %d events, got %d", len(expected), len(app.events))
+	}
+
+	for i, e := range expected {
+		if app.events[i] != e {
+			t.Errorf("Event %d: expected %v, got %v", i, e, app.events[i])
+		}
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestInputStateResetAfterUpdate(t *testing.T) {
+	Register(&testApp{})
+	gogiInit(10, 10)
+
+	gogiKeyEvent(input.KeySpace, 1)
+	gogiMouseMove(7, 8)
+
+	if !Input().IsKeyPressed(input.KeySpace) || !Input().IsKeyDown(input.KeySpace) {
+		t.Error("Expected the space key to be pressed and down")
+	}
+
+	gogiUpdate(0.1)
+
+	if Input().IsKeyPressed(input.KeySpace) || !Input().IsKeyDown(input.KeySpace) {
+		t.Error("Expected only the pressed state to be reset after the update")
+	}
+
+	if x, y := Input().MousePosition(); x != 7 || y != 8 {
+		t.Errorf("Expected mouse at (7, 8), got (%d, %d)", x, y)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestExportsBeforeInit(t *testing.T) {
+	Register(&testApp{})
+
+	gogiUpdate(1)
+	gogiClearDirtyRects()
+
+	if gogiBufferPointer() != 0 || gogiBufferLength() != 0 || gogiDirtyRectCount() != 0 {
+		t.Error("Expected empty results before the host is initialised")
+	}
+}
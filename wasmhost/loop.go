@@ -0,0 +1,69 @@
+package wasmhost
+
+import (
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/input"
+	"github.com/ewaldhorn/gogi/timing"
+)
+
+// ------------------------------------------------------------------------------------------------
+// LoopGame is a fixed timestep game that can be hosted directly. It may also implement
+// Resizer and InputHandler.
+type LoopGame interface {
+	Init(c *canvas.GogiCanvas)
+	timing.Game
+}
+
+// ------------------------------------------------------------------------------------------------
+// loopApp drives a LoopGame through a timing.Loop, turning the frame times reported by
+// JavaScript into fixed updates.
+type loopApp struct {
+	game    LoopGame
+	loop    *timing.Loop
+	updates int
+}
+
+// ------------------------------------------------------------------------------------------------
+// RegisterLoop hosts a fixed timestep game, running a fixed update every step seconds.
+// The returned loop can be used to pause, resume or scale time.
+func RegisterLoop(game LoopGame, step float64, options ...Option) *timing.Loop {
+	app := &loopApp{game: game}
+	app.loop = timing.NewLoop(game, step)
+
+	Register(app, options...)
+	return app.loop
+}
+
+// ------------------------------------------------------------------------------------------------
+func (a *loopApp) Init(c *canvas.GogiCanvas) {
+	a.game.Init(c)
+}
+
+// ------------------------------------------------------------------------------------------------
+func (a *loopApp) Update(dt float64) {
+	a.updates = a.loop.Advance(dt)
+}
+
+// ------------------------------------------------------------------------------------------------
+// inputSeen holds on to key presses and other single frame input until a fixed update has
+// run, since frames shorter than the step only render. A paused loop lets it go, otherwise
+// everything pressed during the pause would arrive at once on resume.
+func (a *loopApp) inputSeen() bool {
+	return a.updates > 0 || a.loop.IsPaused()
+}
+
+// ------------------------------------------------------------------------------------------------
+func (a *loopApp) Resize(c *canvas.GogiCanvas) {
+	if resizer, ok := a.game.(Resizer); ok {
+		resizer.Resize(c)
+	} else {
+		a.game.Init(c)
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func (a *loopApp) HandleEvent(e input.Event) {
+	if handler, ok := a.game.(InputHandler); ok {
+		handler.HandleEvent(e)
+	}
+}
@@ -0,0 +1,81 @@
+package wasmhost
+
+import (
+	"testing"
+
+	"github.com/ewaldhorn/gogi/canvas"
+	"github.com/ewaldhorn/gogi/input"
+)
+
+// ------------------------------------------------------------------------------------------------
+type testLoopGame struct {
+	canvas  *canvas.GogiCanvas
+	updates int
+	renders int
+}
+
+func (g *testLoopGame) Init(c *canvas.GogiCanvas) {
+	g.canvas = c
+}
+
+func (g *testLoopGame) FixedUpdate(step float64) {
+	g.updates++
+}
+
+func (g *testLoopGame) Render(alpha float64) {
+	g.renders++
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRegisterLoop(t *testing.T) {
+	game := &testLoopGame{}
+	loop := RegisterLoop(game, 0.05)
+
+	gogiInit(10, 10)
+	if game.canvas == nil {
+		t.Fatal("Expected the game to be initialised")
+	}
+
+	gogiUpdate(0.175)
+	if game.updates != 3 || game.renders != 1 {
+		t.Errorf("Expected 3 updates and 1 render, got %d and %d", game.updates, game.renders)
+	}
+
+	loop.Pause()
+	gogiUpdate(0.175)
+	if game.updates != 3 || game.renders != 2 {
+		t.Error("Expected the paused loop to only render")
+	}
+
+	gogiResize(20, 20)
+	if game.canvas.Width() != 20 {
+		t.Error("Expected the game to receive the resized canvas")
+	}
+}
+
+// ------------------------------------------------------------------------------------------------
+func TestRegisterLoopKeepsInputUntilFixedUpdate(t *testing.T) {
+	game := &testLoopGame{}
+	loop := RegisterLoop(game, 0.05)
+	gogiInit(10, 10)
+
+	gogiKeyEvent(input.KeySpace, 1)
+
+	// shorter than a step, so only a render runs and the press must survive it
+	gogiUpdate(0.02)
+	if game.updates != 0 || !Input().IsKeyPressed(input.KeySpace) {
+		t.Fatalf("Expected the key press to be kept without a fixed update, got %d updates", game.updates)
+	}
+
+	gogiUpdate(0.04)
+	if game.updates != 1 || Input().IsKeyPressed(input.KeySpace) {
+		t.Errorf("Expected the key press to be reset after the fixed update, got %d updates", game.updates)
+	}
+
+	loop.Pause()
+	gogiKeyEvent(input.KeySpace, 0)
+	gogiUpdate(0.02)
+	if Input().IsKeyReleased(input.KeySpace) {
+		t.Error("Expected a paused loop to reset the key release")
+	}
+}